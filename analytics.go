@@ -2,6 +2,7 @@ package sandwich
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -10,6 +11,8 @@ import (
 // EventMetrics tracks event-related metrics.
 var EventMetrics = struct {
 	EventsTotal    *prometheus.CounterVec
+	FilteredEvents *prometheus.CounterVec
+	PublishedBytes *prometheus.CounterVec
 	GatewayLatency *prometheus.GaugeVec
 }{
 	EventsTotal: promauto.NewCounterVec(
@@ -19,6 +22,20 @@ var EventMetrics = struct {
 		},
 		[]string{"application_identifier", "event_type"},
 	),
+	FilteredEvents: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandwich_filtered_events_total",
+			Help: "Total number of events not produced due to filters, split by identifier, event type and reason",
+		},
+		[]string{"application_identifier", "event_type", "reason"},
+	),
+	PublishedBytes: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandwich_published_bytes_total",
+			Help: "Total size of the data of published events in bytes, split by identifier and event type",
+		},
+		[]string{"application_identifier", "event_type"},
+	),
 	GatewayLatency: promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "sandwich_gateway_latency_seconds",
@@ -32,10 +49,186 @@ func RecordEvent(identifier, eventType string) {
 	EventMetrics.EventsTotal.WithLabelValues(identifier, eventType).Inc()
 }
 
+func RecordFilteredEvent(identifier, eventType, reason string) {
+	EventMetrics.FilteredEvents.WithLabelValues(identifier, eventType, reason).Inc()
+}
+
+func RecordPublishedBytes(identifier, eventType string, size int) {
+	EventMetrics.PublishedBytes.WithLabelValues(identifier, eventType).Add(float64(size))
+}
+
 func UpdateGatewayLatency(identifier string, shardID int32, latency float64) {
 	EventMetrics.GatewayLatency.WithLabelValues(identifier, strconv.Itoa(int(shardID))).Set(latency)
 }
 
+// ProducerMetrics tracks producer route, retry and dead letter metrics.
+var ProducerMetrics = struct {
+	RoutePublished       *prometheus.CounterVec
+	RouteErrors          *prometheus.CounterVec
+	RoutePublishDuration *prometheus.HistogramVec
+	Retries              *prometheus.CounterVec
+	Failures             *prometheus.CounterVec
+	DeadLetterBytes      *prometheus.GaugeVec
+	DeadLetterEvents     *prometheus.GaugeVec
+	WebhookRequests      *prometheus.CounterVec
+}{
+	RoutePublished: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandwich_producer_route_published_total",
+			Help: "Total number of events published by producer routes, split by identifier, route and producer",
+		},
+		[]string{"application_identifier", "route", "producer"},
+	),
+	RouteErrors: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandwich_producer_route_errors_total",
+			Help: "Total number of events that producer routes failed to publish, split by identifier, route and producer",
+		},
+		[]string{"application_identifier", "route", "producer"},
+	),
+	RoutePublishDuration: promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "sandwich_producer_route_publish_duration_seconds",
+			Help:    "Time taken to publish events by producer routes, split by identifier, route and producer",
+			Buckets: prometheus.ExponentialBuckets(0.0001, 4, 10),
+		},
+		[]string{"application_identifier", "route", "producer"},
+	),
+	Retries: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandwich_producer_retries_total",
+			Help: "Total number of times publishing an event was retried, split by identifier and producer",
+		},
+		[]string{"application_identifier", "producer"},
+	),
+	Failures: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandwich_producer_failures_total",
+			Help: "Total number of events that failed to publish after every retry, split by identifier and producer",
+		},
+		[]string{"application_identifier", "producer"},
+	),
+	DeadLetterBytes: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sandwich_dead_letter_spool_bytes",
+			Help: "Size of the dead letter file in bytes, split by identifier",
+		},
+		[]string{"application_identifier"},
+	),
+	DeadLetterEvents: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sandwich_dead_letter_spool_events",
+			Help: "Number of events in the dead letter file, split by identifier",
+		},
+		[]string{"application_identifier"},
+	),
+	WebhookRequests: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandwich_webhook_requests_total",
+			Help: "Total number of requests sent by webhook producers, split by identifier and status code",
+		},
+		[]string{"application_identifier", "status"},
+	),
+}
+
+func RecordProducerRetry(identifier, producer string) {
+	ProducerMetrics.Retries.WithLabelValues(identifier, producer).Inc()
+}
+
+func RecordProducerFailure(identifier, producer string) {
+	ProducerMetrics.Failures.WithLabelValues(identifier, producer).Inc()
+}
+
+func UpdateDeadLetterSpool(identifier string, size, count int64) {
+	ProducerMetrics.DeadLetterBytes.WithLabelValues(identifier).Set(float64(size))
+	ProducerMetrics.DeadLetterEvents.WithLabelValues(identifier).Set(float64(count))
+}
+
+// RecordWebhookRequest records a webhook request. The status is 0 when there was no response.
+func RecordWebhookRequest(identifier string, status int) {
+	ProducerMetrics.WebhookRequests.WithLabelValues(identifier, strconv.Itoa(status)).Inc()
+}
+
+func RecordProducerRoutePublish(identifier, route, producer string, duration time.Duration, err error) {
+	ProducerMetrics.RoutePublishDuration.WithLabelValues(identifier, route, producer).Observe(duration.Seconds())
+
+	if err != nil {
+		ProducerMetrics.RouteErrors.WithLabelValues(identifier, route, producer).Inc()
+	} else {
+		ProducerMetrics.RoutePublished.WithLabelValues(identifier, route, producer).Inc()
+	}
+}
+
+// SpoolMetrics tracks write-ahead spool metrics.
+var SpoolMetrics = struct {
+	LagBytes  *prometheus.GaugeVec
+	EventAge  *prometheus.GaugeVec
+	Paused    *prometheus.GaugeVec
+	Forwarded *prometheus.CounterVec
+	Rejected  *prometheus.CounterVec
+}{
+	LagBytes: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sandwich_spool_lag_bytes",
+			Help: "Size of the spooled events that have not been forwarded in bytes, split by identifier",
+		},
+		[]string{"application_identifier"},
+	),
+	EventAge: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sandwich_spool_event_age_seconds",
+			Help: "Time since the event being forwarded was spooled in seconds, split by identifier",
+		},
+		[]string{"application_identifier"},
+	),
+	Paused: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sandwich_spool_paused",
+			Help: "Whether shard reads are paused as the spool is behind, split by identifier",
+		},
+		[]string{"application_identifier"},
+	),
+	Forwarded: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandwich_spool_forwarded_total",
+			Help: "Total number of spooled events forwarded to the producer, split by identifier",
+		},
+		[]string{"application_identifier"},
+	),
+	Rejected: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandwich_spool_rejected_total",
+			Help: "Total number of events rejected as the spool is full, split by identifier",
+		},
+		[]string{"application_identifier"},
+	),
+}
+
+func UpdateSpoolLag(identifier string, bytes int64) {
+	SpoolMetrics.LagBytes.WithLabelValues(identifier).Set(float64(bytes))
+}
+
+func UpdateSpoolEventAge(identifier string, age time.Duration) {
+	SpoolMetrics.EventAge.WithLabelValues(identifier).Set(age.Seconds())
+}
+
+func UpdateSpoolPaused(identifier string, paused bool) {
+	value := 0.0
+	if paused {
+		value = 1
+	}
+
+	SpoolMetrics.Paused.WithLabelValues(identifier).Set(value)
+}
+
+func RecordSpoolForwarded(identifier string) {
+	SpoolMetrics.Forwarded.WithLabelValues(identifier).Inc()
+}
+
+func RecordSpoolRejected(identifier string) {
+	SpoolMetrics.Rejected.WithLabelValues(identifier).Inc()
+}
+
 // GRPCMetrics tracks GRPC-related metrics.
 var GRPCMetrics = struct {
 	Requests prometheus.Counter
@@ -52,6 +245,23 @@ func RecordGRPCRequest() {
 	GRPCMetrics.Requests.Inc()
 }
 
+// ListenerMetrics tracks broadcast listener metrics.
+var ListenerMetrics = struct {
+	DroppedMessages *prometheus.CounterVec
+}{
+	DroppedMessages: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandwich_listener_dropped_messages_total",
+			Help: "Total number of broadcast messages dropped due to full listener buffers, split by overflow policy",
+		},
+		[]string{"policy"},
+	),
+}
+
+func RecordListenerDrop(policy ListenerOverflowPolicy) {
+	ListenerMetrics.DroppedMessages.WithLabelValues(policy.String()).Inc()
+}
+
 // ShardMetrics tracks shard-related metrics.
 var ShardMetrics = struct {
 	ApplicationStatus *prometheus.GaugeVec
@@ -81,6 +291,45 @@ func UpdateShardStatus(identifier string, shardID int32, status ShardStatus) {
 	ShardMetrics.ShardStatus.WithLabelValues(identifier, strconv.Itoa(int(shardID))).Set(float64(status))
 }
 
+// GatewayMetrics tracks the session start limits of applications.
+var GatewayMetrics = struct {
+	SessionStartRemaining  *prometheus.GaugeVec
+	SessionStartTotal      *prometheus.GaugeVec
+	SessionStartResetAfter *prometheus.GaugeVec
+}{
+	SessionStartRemaining: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sandwich_gateway_session_start_remaining",
+			Help: "Number of session starts remaining before the session start limit resets",
+		},
+		[]string{"application_identifier"},
+	),
+	SessionStartTotal: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sandwich_gateway_session_start_total",
+			Help: "Number of session starts allowed per reset",
+		},
+		[]string{"application_identifier"},
+	),
+	SessionStartResetAfter: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sandwich_gateway_session_start_reset_after_seconds",
+			Help: "Seconds until the session start limit resets, as of the last gateway refresh",
+		},
+		[]string{"application_identifier"},
+	),
+}
+
+func UpdateSessionStartLimit(identifier string, remaining, total int32, resetAfter time.Duration) {
+	GatewayMetrics.SessionStartRemaining.WithLabelValues(identifier).Set(float64(remaining))
+	GatewayMetrics.SessionStartTotal.WithLabelValues(identifier).Set(float64(total))
+	GatewayMetrics.SessionStartResetAfter.WithLabelValues(identifier).Set(resetAfter.Seconds())
+}
+
+func UpdateSessionStartRemaining(identifier string, remaining int32) {
+	GatewayMetrics.SessionStartRemaining.WithLabelValues(identifier).Set(float64(remaining))
+}
+
 // StateMetrics tracks state-related metrics
 var StateMetrics = struct {
 	StateRequests prometheus.Counter
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"reflect"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -23,15 +24,33 @@ type Application struct {
 	Sandwich      *Sandwich
 	Configuration *atomic.Pointer[ApplicationConfiguration]
 
+	// botToken is the resolved bot token. The configuration only contains its reference.
+	botToken *atomic.Pointer[string]
+
 	Gateway                           *atomic.Pointer[discord.GatewayBotResponse]
 	gatewaySessionStartLimitRemaining *atomic.Int32
 
 	User *atomic.Pointer[discord.User]
 
-	producer Producer
+	producer       Producer
+	producerClosed *atomic.Bool
+	deadLetters    *DeadLetterSpool
+
+	// readsResumed is closed when shard reads are resumed. It is nil when reads are not paused.
+	readsResumed *atomic.Pointer[chan struct{}]
+
+	// dispatchesInFlight is the number of dispatches currently being handled, used when draining.
+	dispatchesInFlight *atomic.Int64
 
 	ShardCount *atomic.Int32
 
+	presenceRotationIndex  *atomic.Int64
+	presenceRotationCancel *atomic.Pointer[context.CancelFunc]
+
+	// configurationMu serializes configuration updates, so the compiled configuration matches the configuration.
+	configurationMu sync.Mutex
+	compiled        *atomic.Pointer[compiledApplicationConfiguration]
+
 	ready   chan struct{}
 	readyWg sync.WaitGroup
 
@@ -52,15 +71,29 @@ func NewApplication(sandwich *Sandwich, config *ApplicationConfiguration) *Appli
 		Sandwich:      sandwich,
 		Configuration: &atomic.Pointer[ApplicationConfiguration]{},
 
+		botToken: &atomic.Pointer[string]{},
+
 		Gateway:                           &atomic.Pointer[discord.GatewayBotResponse]{},
 		gatewaySessionStartLimitRemaining: &atomic.Int32{},
 
 		User: &atomic.Pointer[discord.User]{},
 
-		producer: nil,
+		producer:       nil,
+		producerClosed: &atomic.Bool{},
+		deadLetters:    nil,
+
+		readsResumed: &atomic.Pointer[chan struct{}]{},
+
+		dispatchesInFlight: &atomic.Int64{},
 
 		ShardCount: &atomic.Int32{},
 
+		presenceRotationIndex:  &atomic.Int64{},
+		presenceRotationCancel: &atomic.Pointer[context.CancelFunc]{},
+
+		configurationMu: sync.Mutex{},
+		compiled:        &atomic.Pointer[compiledApplicationConfiguration]{},
+
 		ready:   make(chan struct{}),
 		readyWg: sync.WaitGroup{},
 
@@ -72,7 +105,7 @@ func NewApplication(sandwich *Sandwich, config *ApplicationConfiguration) *Appli
 		Status: &atomic.Int32{},
 	}
 
-	application.Configuration.Store(config)
+	application.storeConfiguration(config)
 
 	application.SetStatus(ApplicationStatusIdle)
 
@@ -112,33 +145,146 @@ func (application *Application) SetUser(user *discord.User) {
 	})
 }
 
-// Initialize initializes the application. This includes checking the gateway
-func (application *Application) Initialize(ctx context.Context) error {
-	application.Logger.Debug("Initializing application")
+// BotToken returns the resolved bot token of the application. This is empty until the application is initialized.
+func (application *Application) BotToken() string {
+	botToken := application.botToken.Load()
+	if botToken == nil {
+		return ""
+	}
 
-	application.Sandwich.gatewayLimiter.Lock()
+	return *botToken
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discord.EndpointGatewayBot, nil)
+// resolveBotToken resolves the bot token reference in the configuration using the secret provider.
+func (application *Application) resolveBotToken(ctx context.Context) error {
+	botToken, err := application.Sandwich.secretProvider.ResolveSecret(ctx, application.Configuration.Load().BotToken)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to resolve bot token: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Bot "+application.Configuration.Load().BotToken)
+	application.botToken.Store(&botToken)
+
+	return nil
+}
+
+// compiledApplicationConfiguration is the parts of an application configuration that are compiled
+// before they are used when handling events.
+type compiledApplicationConfiguration struct {
+	// configuration is the configuration that was compiled.
+	configuration *ApplicationConfiguration
+
+	guildFilter        *guildFilter
+	eventRules         *EventRules
+	payloadProjections map[string]*PayloadProjection
+}
+
+// compiledConfiguration returns the compiled current configuration.
+func (application *Application) compiledConfiguration() *compiledApplicationConfiguration {
+	return application.compiled.Load()
+}
+
+// storeConfiguration compiles the configuration and then replaces the current configuration with it,
+// so events are never handled with a configuration that has not been compiled.
+func (application *Application) storeConfiguration(configuration *ApplicationConfiguration) {
+	application.configurationMu.Lock()
+	defer application.configurationMu.Unlock()
+
+	eventRules, err := CompileEventRules(configuration.EventRules)
+	if err != nil {
+		// Configurations are validated before they are loaded, so this should not happen.
+		application.Logger.Error("Failed to compile event rules", "error", err)
+	}
+
+	payloadProjections := make(map[string]*PayloadProjection, len(configuration.PayloadProjections))
+
+	for eventType, projectionConfiguration := range configuration.PayloadProjections {
+		projection, err := CompilePayloadProjection(projectionConfiguration)
+		if err != nil {
+			application.Logger.Error("Failed to compile payload projection", "event_type", eventType, "error", err)
+
+			continue
+		}
+
+		payloadProjections[eventType] = projection
+	}
+
+	application.compiled.Store(&compiledApplicationConfiguration{
+		configuration: configuration,
+
+		guildFilter:        newGuildFilter(configuration),
+		eventRules:         eventRules,
+		payloadProjections: payloadProjections,
+	})
+
+	application.Configuration.Store(configuration)
+}
+
+// ensureBotToken returns the bot token, resolving it first if the application has not been initialized.
+func (application *Application) ensureBotToken(ctx context.Context) (string, error) {
+	if botToken := application.BotToken(); botToken != "" {
+		return botToken, nil
+	}
+
+	if err := application.resolveBotToken(ctx); err != nil {
+		return "", err
+	}
+
+	return application.BotToken(), nil
+}
+
+// DoREST sends a request to the Discord REST API using the bot token of the application.
+func (application *Application) DoREST(ctx context.Context, restRequest *RESTRequest) (*RESTResponse, error) {
+	botToken, err := application.ensureBotToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return application.Sandwich.REST.Do(ctx, botToken, restRequest)
+}
+
+// fetchCurrentUser fetches the user a bot token belongs to.
+func (application *Application) fetchCurrentUser(ctx context.Context, botToken string) (*discord.User, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discord.EndpointUser("@me"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bot "+botToken)
 
 	resp, err := application.Sandwich.Client.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to do request: %w", err)
+		return nil, fmt.Errorf("failed to do request: %w", err)
 	}
 
 	defer resp.Body.Close()
 
-	var gatewayBotResponse discord.GatewayBotResponse
-	if err := json.NewDecoder(resp.Body).Decode(&gatewayBotResponse); err != nil {
-		return fmt.Errorf("failed to decode gateway bot response: %w", err)
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, ErrApplicationTokenInvalid
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch current user: unexpected status %d", resp.StatusCode)
 	}
 
-	application.Gateway.Store(&gatewayBotResponse)
-	application.gatewaySessionStartLimitRemaining.Store(gatewayBotResponse.SessionStartLimit.Remaining)
+	var user discord.User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode current user: %w", err)
+	}
+
+	return &user, nil
+}
+
+// Initialize initializes the application. This includes checking the gateway
+func (application *Application) Initialize(ctx context.Context) error {
+	application.Logger.Debug("Initializing application")
+
+	if err := application.resolveBotToken(ctx); err != nil {
+		return err
+	}
+
+	if _, err := application.RefreshGateway(ctx); err != nil {
+		return err
+	}
 
 	configuration := application.Configuration.Load()
 
@@ -149,12 +295,20 @@ func (application *Application) Initialize(ctx context.Context) error {
 		clientName = fmt.Sprintf("%s-%s", clientName, randomHex(8))
 	}
 
-	producer, err := application.Sandwich.producerProvider.GetProducer(ctx, configuration.ApplicationIdentifier, clientName)
+	// Close the previous producer, if it was not closed when the application was stopped. This is done before
+	// creating the new producer, as producers such as the spool own files that can only be open once.
+	if _, err := application.closeProducer(); err != nil {
+		application.Logger.Error("Failed to close previous producer", "error", err)
+	}
+
+	producer, deadLetters, err := application.newProducer(ctx, configuration, clientName)
 	if err != nil {
 		return fmt.Errorf("failed to get producer: %w", err)
 	}
 
 	application.producer = producer
+	application.deadLetters = deadLetters
+	application.producerClosed.Store(false)
 
 	application.Logger.Debug("Application initialized")
 
@@ -191,25 +345,88 @@ func (application *Application) Start(ctx context.Context) error {
 
 	application.SetStatus(ApplicationStatusReady)
 
+	application.startPresenceRotation(ctx)
+
 	return nil
 }
 
+// Stop gracefully stops the application. See Shutdown for a report of what was drained.
 func (application *Application) Stop(ctx context.Context) error {
-	application.SetStatus(ApplicationStatusStopping)
+	return application.Shutdown(ctx).err()
+}
+
+// Restart stops the application, re-initializes it with a new configuration and starts it again
+// if it was previously running.
+func (application *Application) Restart(ctx context.Context, configuration *ApplicationConfiguration) error {
+	application.Logger.Info("Restarting application")
+
+	wasRunning := application.IsRunning()
+
+	if wasRunning {
+		if err := application.Stop(ctx); err != nil {
+			return fmt.Errorf("failed to stop application: %w", err)
+		}
+	}
+
+	// The shard IDs may change, so stopped shards that are no longer configured must not be kept around.
+	application.Shards.Clear()
+
+	application.storeConfiguration(configuration)
+
+	if err := application.Initialize(ctx); err != nil {
+		application.SetStatus(ApplicationStatusFailed)
+
+		return fmt.Errorf("failed to initialize application: %w", err)
+	}
+
+	if wasRunning {
+		go func() {
+			if err := application.Start(ctx); err != nil {
+				application.Logger.Error("Failed to start application after restart", "error", err)
+				application.SetStatus(ApplicationStatusFailed)
+			}
+		}()
+	}
+
+	return nil
+}
+
+// UpdateConfiguration swaps the configuration in place. This is used for changes
+// that do not require the application to be restarted. Presence rotation is restarted
+// with the new presences if they changed.
+func (application *Application) UpdateConfiguration(configuration *ApplicationConfiguration) {
+	previous := application.Configuration.Load()
+
+	application.storeConfiguration(configuration)
+
+	// Presence rotation is started once the application is ready, so it is only restarted here if it already was.
+	if ApplicationStatus(application.Status.Load()) == ApplicationStatusReady &&
+		(previous == nil || !reflect.DeepEqual(previous.PresenceRotation, configuration.PresenceRotation)) {
+		application.startPresenceRotation(context.Background())
+	}
+
+	// Metadata includes the application user, so it can only be set once the application has identified.
+	if application.User.Load() == nil {
+		return
+	}
 
 	application.Shards.Range(func(_ int32, shard *Shard) bool {
-		shard.Stop(ctx, websocket.StatusNormalClosure)
+		shard.SetMetadata(configuration)
 
 		return true
 	})
+}
 
-	if application.producer != nil {
-		application.producer.Close()
+// IsRunning returns true if the application is starting or has running shards.
+func (application *Application) IsRunning() bool {
+	switch ApplicationStatus(application.Status.Load()) {
+	case ApplicationStatusStarting, ApplicationStatusConnecting, ApplicationStatusConnected, ApplicationStatusReady:
+		return true
+	case ApplicationStatusIdle, ApplicationStatusFailed, ApplicationStatusStopping, ApplicationStatusStopped:
+		return false
 	}
 
-	application.SetStatus(ApplicationStatusStopped)
-
-	return nil
+	return false
 }
 
 // GetInitialShardCount returns the shard IDs and shard count for the application.
@@ -276,9 +493,10 @@ func (application *Application) StartShards(ctx context.Context, shardIDs []int3
 		return ready, ErrApplicationMissingShards
 	}
 
-	// Kill any shards that are already running
-	application.Shards.Range(func(_ int32, shard *Shard) bool {
+	// Kill any shards that are already running, they are replaced by the new shards below.
+	application.Shards.Range(func(shardID int32, shard *Shard) bool {
 		shard.Stop(ctx, websocket.StatusNormalClosure)
+		application.Shards.Delete(shardID)
 
 		return true
 	})
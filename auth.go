@@ -0,0 +1,414 @@
+package sandwich
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	pb "github.com/WelcomerTeam/Sandwich-Daemon/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Permission is a class of operations a principal is allowed to perform.
+type Permission string
+
+const (
+	// PermissionReadState allows fetching applications and cached state.
+	PermissionReadState Permission = "read_state"
+	// PermissionControlApplications allows starting and stopping applications and requesting guild chunks.
+	PermissionControlApplications Permission = "control_applications"
+	// PermissionSendGateway allows sending and relaying gateway messages.
+	PermissionSendGateway Permission = "send_gateway"
+	// PermissionDiscordREST allows sending requests to the Discord REST API as an application.
+	PermissionDiscordREST Permission = "discord_rest"
+	// PermissionManageConfig allows creating and deleting applications, changing the configuration,
+	// listening to all events and reading bot tokens.
+	PermissionManageConfig Permission = "manage_config"
+)
+
+var validPermissions = []Permission{
+	PermissionReadState,
+	PermissionControlApplications,
+	PermissionSendGateway,
+	PermissionDiscordREST,
+	PermissionManageConfig,
+}
+
+// AllApplications grants a principal access to every application.
+const AllApplications = "*"
+
+// AuthPolicy is the set of principals that are allowed to use the API.
+type AuthPolicy struct {
+	Principals []*Principal `json:"principals"`
+}
+
+// Principal is a caller of the API.
+type Principal struct {
+	Name string `json:"name"`
+
+	// BearerTokens authenticate the principal with an "authorization: Bearer <token>" header.
+	BearerTokens []string `json:"bearer_tokens"`
+	// CertificateIdentities authenticate the principal with a verified client certificate. They are matched
+	// against the subject common name and the DNS, email and URI subject alternative names.
+	CertificateIdentities []string `json:"certificate_identities"`
+
+	// Applications the principal can access. Use AllApplications to allow every application.
+	Applications []string `json:"applications"`
+	// Permissions the principal has for those applications.
+	Permissions []Permission `json:"permissions"`
+}
+
+// HasPermission returns true if the principal has a permission.
+func (principal *Principal) HasPermission(permission Permission) bool {
+	return slices.Contains(principal.Permissions, permission)
+}
+
+// CanAccessApplication returns true if the principal can access an application.
+func (principal *Principal) CanAccessApplication(applicationIdentifier string) bool {
+	return principal.HasAllApplications() || slices.Contains(principal.Applications, applicationIdentifier)
+}
+
+// HasAllApplications returns true if the principal is not scoped to specific applications.
+func (principal *Principal) HasAllApplications() bool {
+	return slices.Contains(principal.Applications, AllApplications)
+}
+
+type applicationScope int
+
+const (
+	// scopeApplicationField is a method that acts on the application in the request.
+	scopeApplicationField applicationScope = iota
+	// scopeShared is a method that reads state that is shared between applications.
+	scopeShared
+	// scopeGlobal is a method that acts on every application, so it requires access to all applications.
+	scopeGlobal
+)
+
+type methodAuthorization struct {
+	permission Permission
+	scope      applicationScope
+	// applicationField is the request field that contains the application identifier.
+	applicationField protoreflect.Name
+}
+
+// methodAuthorizations is the permission required for each method. Methods that are not listed are denied.
+var methodAuthorizations = map[string]methodAuthorization{
+	pb.Sandwich_Listen_FullMethodName:                 {PermissionManageConfig, scopeGlobal, ""},
+	pb.Sandwich_RelayMessage_FullMethodName:           {PermissionSendGateway, scopeApplicationField, "identifier"},
+	pb.Sandwich_ReloadConfiguration_FullMethodName:    {PermissionManageConfig, scopeGlobal, ""},
+	pb.Sandwich_ReconcileConfiguration_FullMethodName: {PermissionManageConfig, scopeGlobal, ""},
+	pb.Sandwich_ValidateConfiguration_FullMethodName:  {PermissionManageConfig, scopeGlobal, ""},
+	pb.Sandwich_ListConfigVersions_FullMethodName:     {PermissionManageConfig, scopeGlobal, ""},
+	pb.Sandwich_RollbackConfig_FullMethodName:         {PermissionManageConfig, scopeGlobal, ""},
+
+	pb.Sandwich_FetchApplication_FullMethodName:  {PermissionReadState, scopeApplicationField, "application_identifier"},
+	pb.Sandwich_StartApplication_FullMethodName:  {PermissionControlApplications, scopeApplicationField, "application_identifier"},
+	pb.Sandwich_StopApplication_FullMethodName:   {PermissionControlApplications, scopeApplicationField, "application_identifier"},
+	pb.Sandwich_CreateApplication_FullMethodName: {PermissionManageConfig, scopeApplicationField, "application_identifier"},
+	pb.Sandwich_DeleteApplication_FullMethodName: {PermissionManageConfig, scopeApplicationField, "application_identifier"},
+
+	pb.Sandwich_RotateApplicationToken_FullMethodName: {PermissionManageConfig, scopeApplicationField, "application_identifier"},
+	pb.Sandwich_ReplayDeadLetters_FullMethodName:      {PermissionControlApplications, scopeApplicationField, "application_identifier"},
+
+	pb.Sandwich_RequestGuildChunk_FullMethodName:    {PermissionControlApplications, scopeGlobal, ""},
+	pb.Sandwich_SendWebsocketMessage_FullMethodName: {PermissionSendGateway, scopeApplicationField, "identifier"},
+	pb.Sandwich_DiscordREST_FullMethodName:          {PermissionDiscordREST, scopeApplicationField, "application_identifier"},
+
+	pb.Sandwich_WhereIsGuild_FullMethodName:          {PermissionReadState, scopeShared, ""},
+	pb.Sandwich_FetchAllGuildIDs_FullMethodName:      {PermissionReadState, scopeApplicationField, "identifier"},
+	pb.Sandwich_FetchGuild_FullMethodName:            {PermissionReadState, scopeShared, ""},
+	pb.Sandwich_FetchGuildMember_FullMethodName:      {PermissionReadState, scopeShared, ""},
+	pb.Sandwich_FetchGuildChannel_FullMethodName:     {PermissionReadState, scopeShared, ""},
+	pb.Sandwich_FetchGuildRole_FullMethodName:        {PermissionReadState, scopeShared, ""},
+	pb.Sandwich_FetchGuildEmoji_FullMethodName:       {PermissionReadState, scopeShared, ""},
+	pb.Sandwich_FetchGuildSticker_FullMethodName:     {PermissionReadState, scopeShared, ""},
+	pb.Sandwich_FetchGuildVoiceState_FullMethodName:  {PermissionReadState, scopeShared, ""},
+	pb.Sandwich_FetchUser_FullMethodName:             {PermissionReadState, scopeShared, ""},
+	pb.Sandwich_FetchUserMutualGuilds_FullMethodName: {PermissionReadState, scopeShared, ""},
+	pb.Sandwich_FetchVoiceStates_FullMethodName:      {PermissionReadState, scopeShared, ""},
+}
+
+// Services that do not require authentication, such as health checks used by probes.
+var unauthenticatedServices = []string{
+	"/grpc.health.v1.Health/",
+}
+
+type principalKey struct{}
+
+// PrincipalFromContext returns the authenticated principal of a request.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+
+	return principal, ok
+}
+
+// Authenticator authenticates and authorizes API requests against an AuthPolicy.
+// Use UnaryServerInterceptor and StreamServerInterceptor when creating the grpc.Server, and
+// WithAuthenticator to also protect the HTTP API.
+type Authenticator struct {
+	logger *slog.Logger
+
+	// Bearer tokens are stored hashed so lookups do not compare the token itself.
+	tokens       map[[sha256.Size]byte]*Principal
+	certificates map[string]*Principal
+}
+
+func NewAuthenticator(logger *slog.Logger, policy AuthPolicy) (*Authenticator, error) {
+	authenticator := &Authenticator{
+		logger: logger.With("service", "auth"),
+
+		tokens:       make(map[[sha256.Size]byte]*Principal),
+		certificates: make(map[string]*Principal),
+	}
+
+	for index, principal := range policy.Principals {
+		if principal == nil {
+			return nil, fmt.Errorf("principals[%d]: %w", index, ErrConfigurationMissingField)
+		}
+
+		if len(principal.BearerTokens) == 0 && len(principal.CertificateIdentities) == 0 {
+			return nil, fmt.Errorf("principals[%d]: principal %q has no bearer tokens or certificate identities", index, principal.Name)
+		}
+
+		for _, permission := range principal.Permissions {
+			if !slices.Contains(validPermissions, permission) {
+				return nil, fmt.Errorf("principals[%d]: unknown permission %q", index, permission)
+			}
+		}
+
+		for _, token := range principal.BearerTokens {
+			hash := sha256.Sum256([]byte(token))
+
+			if _, ok := authenticator.tokens[hash]; ok {
+				return nil, fmt.Errorf("principals[%d]: bearer token is used by multiple principals", index)
+			}
+
+			authenticator.tokens[hash] = principal
+		}
+
+		for _, identity := range principal.CertificateIdentities {
+			if _, ok := authenticator.certificates[identity]; ok {
+				return nil, fmt.Errorf("principals[%d]: certificate identity %q is used by multiple principals", index, identity)
+			}
+
+			authenticator.certificates[identity] = principal
+		}
+	}
+
+	return authenticator, nil
+}
+
+// WithAuthenticator protects the HTTP API with an authenticator.
+// The gRPC server must be created with the interceptors from the authenticator.
+func (sandwich *Sandwich) WithAuthenticator(authenticator *Authenticator) *Sandwich {
+	sandwich.authenticator.Store(authenticator)
+
+	return sandwich
+}
+
+func (authenticator *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isUnauthenticatedMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		principal, err := authenticator.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := authenticator.authorize(principal, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+
+		return handler(context.WithValue(ctx, principalKey{}, principal), req)
+	}
+}
+
+func (authenticator *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isUnauthenticatedMethod(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		principal, err := authenticator.authenticate(stream.Context())
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authorizedServerStream{
+			ServerStream: stream,
+			ctx:          context.WithValue(stream.Context(), principalKey{}, principal),
+			authorize: func(req any) error {
+				return authenticator.authorize(principal, info.FullMethod, req)
+			},
+		})
+	}
+}
+
+// authorizedServerStream authorizes every message that is received, as the request
+// is not known when the stream is intercepted.
+type authorizedServerStream struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	authorize func(req any) error
+}
+
+func (stream *authorizedServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *authorizedServerStream) RecvMsg(m any) error {
+	if err := stream.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return stream.authorize(m)
+}
+
+// authenticate finds the principal for a request, first by bearer token and then by client certificate.
+func (authenticator *Authenticator) authenticate(ctx context.Context) (*Principal, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, authorization := range md.Get("authorization") {
+			token, ok := strings.CutPrefix(authorization, "Bearer ")
+			if !ok {
+				continue
+			}
+
+			if principal, ok := authenticator.tokens[sha256.Sum256([]byte(token))]; ok {
+				return principal, nil
+			}
+
+			return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 {
+			for _, identity := range certificateIdentities(tlsInfo.State.VerifiedChains[0][0]) {
+				if principal, ok := authenticator.certificates[identity]; ok {
+					return principal, nil
+				}
+			}
+
+			return nil, status.Error(codes.Unauthenticated, "client certificate is not allowed")
+		}
+	}
+
+	return nil, status.Error(codes.Unauthenticated, "missing bearer token or client certificate")
+}
+
+// authorize checks the principal has the permission and application access required for a request.
+func (authenticator *Authenticator) authorize(principal *Principal, fullMethod string, req any) error {
+	err := authorizeRequest(principal, fullMethod, req)
+	if err != nil {
+		authenticator.logger.Warn("Denied request", "principal", principal.Name, "method", fullMethod, "error", err)
+	}
+
+	return err
+}
+
+func authorizeRequest(principal *Principal, fullMethod string, req any) error {
+	authorization, ok := methodAuthorizations[fullMethod]
+	if !ok {
+		// Methods from other services, such as reflection, only require authentication.
+		if !strings.HasPrefix(fullMethod, "/"+pb.Sandwich_ServiceDesc.ServiceName+"/") {
+			return nil
+		}
+
+		return status.Errorf(codes.PermissionDenied, "method %s is not allowed", fullMethod)
+	}
+
+	if !principal.HasPermission(authorization.permission) {
+		return status.Errorf(codes.PermissionDenied, "%s requires the %s permission", fullMethod, authorization.permission)
+	}
+
+	// Reading bot tokens is limited to principals that can manage the config.
+	if fetchApplicationRequest, ok := req.(*pb.FetchApplicationRequest); ok && fetchApplicationRequest.GetIncludeBotToken() &&
+		!principal.HasPermission(PermissionManageConfig) {
+		return status.Errorf(codes.PermissionDenied, "include_bot_token requires the %s permission", PermissionManageConfig)
+	}
+
+	switch authorization.scope {
+	case scopeShared:
+		return nil
+	case scopeGlobal:
+		if !principal.HasAllApplications() {
+			return status.Errorf(codes.PermissionDenied, "%s requires access to all applications", fullMethod)
+		}
+
+		return nil
+	case scopeApplicationField:
+		if principal.HasAllApplications() {
+			return nil
+		}
+
+		applicationIdentifier := requestStringField(req, authorization.applicationField)
+
+		// An empty identifier acts on every application.
+		if applicationIdentifier == "" {
+			return status.Errorf(codes.PermissionDenied, "%s must be set to an application the principal can access", authorization.applicationField)
+		}
+
+		if !principal.CanAccessApplication(applicationIdentifier) {
+			return status.Errorf(codes.PermissionDenied, "application %q is not allowed", applicationIdentifier)
+		}
+	}
+
+	return nil
+}
+
+func requestStringField(req any, name protoreflect.Name) string {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	reflection := message.ProtoReflect()
+
+	field := reflection.Descriptor().Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.StringKind {
+		return ""
+	}
+
+	return reflection.Get(field).String()
+}
+
+func certificateIdentities(certificate *x509.Certificate) []string {
+	identities := make([]string, 0, 1+len(certificate.DNSNames)+len(certificate.EmailAddresses)+len(certificate.URIs))
+
+	if certificate.Subject.CommonName != "" {
+		identities = append(identities, certificate.Subject.CommonName)
+	}
+
+	identities = append(identities, certificate.DNSNames...)
+	identities = append(identities, certificate.EmailAddresses...)
+
+	for _, uri := range certificate.URIs {
+		identities = append(identities, uri.String())
+	}
+
+	return identities
+}
+
+func isUnauthenticatedMethod(fullMethod string) bool {
+	for _, service := range unauthenticatedServices {
+		if strings.HasPrefix(fullMethod, service) {
+			return true
+		}
+	}
+
+	return false
+}
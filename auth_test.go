@@ -0,0 +1,85 @@
+package sandwich_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	sandwich_protobuf "github.com/WelcomerTeam/Sandwich-Daemon/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthenticatorUnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	authenticator, err := sandwich.NewAuthenticator(slog.Default(), sandwich.AuthPolicy{
+		Principals: []*sandwich.Principal{
+			{
+				Name:         "admin",
+				BearerTokens: []string{"admin-token"},
+				Applications: []string{sandwich.AllApplications},
+				Permissions:  []sandwich.Permission{sandwich.PermissionReadState, sandwich.PermissionManageConfig},
+			},
+			{
+				Name:         "welcomer",
+				BearerTokens: []string{"welcomer-token"},
+				Applications: []string{"welcomer"},
+				Permissions:  []sandwich.Permission{sandwich.PermissionReadState, sandwich.PermissionControlApplications},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	interceptor := authenticator.UnaryServerInterceptor()
+
+	handler := func(ctx context.Context, _ any) (any, error) {
+		// Unauthenticated services, such as health checks, have no principal.
+		if principal, ok := sandwich.PrincipalFromContext(ctx); ok {
+			return principal.Name, nil
+		}
+
+		return "", nil
+	}
+
+	call := func(token, method string, req any) (any, codes.Code) {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+
+		resp, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+
+		return resp, status.Code(err)
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		method string
+		req    any
+		code   codes.Code
+	}{
+		{"missing token", "", sandwich_protobuf.Sandwich_FetchGuild_FullMethodName, &sandwich_protobuf.FetchGuildRequest{}, codes.Unauthenticated},
+		{"invalid token", "invalid", sandwich_protobuf.Sandwich_FetchGuild_FullMethodName, &sandwich_protobuf.FetchGuildRequest{}, codes.Unauthenticated},
+		{"shared state", "welcomer-token", sandwich_protobuf.Sandwich_FetchGuild_FullMethodName, &sandwich_protobuf.FetchGuildRequest{}, codes.OK},
+		{"scoped application", "welcomer-token", sandwich_protobuf.Sandwich_StartApplication_FullMethodName, &sandwich_protobuf.ApplicationIdentifierWithBlocking{ApplicationIdentifier: "welcomer"}, codes.OK},
+		{"other application", "welcomer-token", sandwich_protobuf.Sandwich_StartApplication_FullMethodName, &sandwich_protobuf.ApplicationIdentifierWithBlocking{ApplicationIdentifier: "other"}, codes.PermissionDenied},
+		{"all applications", "welcomer-token", sandwich_protobuf.Sandwich_FetchApplication_FullMethodName, &sandwich_protobuf.FetchApplicationRequest{}, codes.PermissionDenied},
+		{"missing permission", "welcomer-token", sandwich_protobuf.Sandwich_DeleteApplication_FullMethodName, &sandwich_protobuf.ApplicationIdentifier{ApplicationIdentifier: "welcomer"}, codes.PermissionDenied},
+		{"bot token", "welcomer-token", sandwich_protobuf.Sandwich_FetchApplication_FullMethodName, &sandwich_protobuf.FetchApplicationRequest{ApplicationIdentifier: "welcomer", IncludeBotToken: true}, codes.PermissionDenied},
+		{"global method", "welcomer-token", sandwich_protobuf.Sandwich_ReconcileConfiguration_FullMethodName, &sandwich_protobuf.ReconcileConfigurationRequest{}, codes.PermissionDenied},
+		{"admin global method", "admin-token", sandwich_protobuf.Sandwich_ReconcileConfiguration_FullMethodName, &sandwich_protobuf.ReconcileConfigurationRequest{}, codes.OK},
+		{"admin bot token", "admin-token", sandwich_protobuf.Sandwich_FetchApplication_FullMethodName, &sandwich_protobuf.FetchApplicationRequest{IncludeBotToken: true}, codes.OK},
+		{"health check", "", "/grpc.health.v1.Health/Check", nil, codes.OK},
+	}
+
+	for _, test := range tests {
+		_, code := call(test.token, test.method, test.req)
+		assert.Equal(t, test.code, code, test.name)
+	}
+}
@@ -44,11 +44,37 @@ type ApplicationConfiguration struct {
 	Intents            int32                `json:"intents"`
 	ChunkGuildsOnStart bool                 `json:"chunk_guilds_on_start"`
 
+	// PresenceRotation cycles the presence of every shard. DefaultPresence is used when it is empty.
+	PresenceRotation PresenceRotationConfiguration `json:"presence_rotation"`
+
 	// Events that the application should not handle.
 	EventBlacklist []string `json:"event_blacklist"`
 	// Events that the application should handle, but will not be produced.
 	ProduceBlacklist []string `json:"produce_blacklist"`
 
+	// Guilds that events will be produced for. When empty, events are produced for every guild.
+	GuildAllowList []discord.Snowflake `json:"guild_allow_list"`
+	// Guilds that events will not be produced for.
+	GuildDenyList []discord.Snowflake `json:"guild_deny_list"`
+	// When true, events filtered by the guild lists do not update state, although shards still track their guilds.
+	// Otherwise, they still update state.
+	GuildFilterSkipState bool `json:"guild_filter_skip_state"`
+
+	// Rules that decide if events are produced, checked after the produce blacklist and guild lists.
+	EventRules []EventRuleConfiguration `json:"event_rules"`
+
+	// Fields to keep or remove from the data of produced events, keyed by event type.
+	PayloadProjections map[string]PayloadProjectionConfiguration `json:"payload_projections"`
+
+	// Named producers that producer routes can publish events to.
+	Producers []ProducerConfiguration `json:"producers"`
+	// Routes that decide which producers events are published to. When empty, every event is published to the default producer.
+	ProducerRoutes []ProducerRouteConfiguration `json:"producer_routes"`
+	// Retries events that producers fail to publish.
+	ProducerRetry ProducerRetryConfiguration `json:"producer_retry"`
+	// Writes events to disk before they are published, so they are kept while producers are unavailable.
+	ProducerSpool ProducerSpoolConfiguration `json:"producer_spool"`
+
 	AutoSharded bool   `json:"auto_sharded"`
 	ShardCount  int32  `json:"shard_count"`
 	ShardIDs    string `json:"shard_ids"`
@@ -62,13 +88,15 @@ type ConfigProvider interface {
 }
 
 // ConfigProviderFromPath is a basic config provider that reads and writes to a file.
+// Saves are atomic and previous versions are kept in a history directory next to the file.
 
 type ConfigProviderFromPath struct {
-	path string
+	path    string
+	history configHistory
 }
 
 func NewConfigProviderFromPath(path string) ConfigProviderFromPath {
-	return ConfigProviderFromPath{path}
+	return ConfigProviderFromPath{path, newConfigHistory(path)}
 }
 
 func (c ConfigProviderFromPath) GetConfig(_ context.Context) (*Configuration, error) {
@@ -77,6 +105,10 @@ func (c ConfigProviderFromPath) GetConfig(_ context.Context) (*Configuration, er
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
+	return c.parse(data)
+}
+
+func (c ConfigProviderFromPath) parse(data []byte) (*Configuration, error) {
 	var config Configuration
 	if err := json.Unmarshal(data, &config); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal config file: %w", err)
@@ -87,7 +119,7 @@ func (c ConfigProviderFromPath) GetConfig(_ context.Context) (*Configuration, er
 	return &config, nil
 }
 
-func (c ConfigProviderFromPath) SaveConfig(_ context.Context, config *Configuration) error {
+func (c ConfigProviderFromPath) SaveConfig(ctx context.Context, config *Configuration) error {
 	data, err := json.Marshal(config)
 	if err != nil {
 		return fmt.Errorf("failed to marshal config: %w", err)
@@ -95,5 +127,22 @@ func (c ConfigProviderFromPath) SaveConfig(_ context.Context, config *Configurat
 
 	slog.Debug("Saving config", "config", config)
 
-	return os.WriteFile(c.path, data, 0o600)
+	return c.history.save(ctx, data)
+}
+
+func (c ConfigProviderFromPath) ListConfigVersions(_ context.Context) ([]ConfigVersion, error) {
+	return c.history.list()
+}
+
+func (c ConfigProviderFromPath) GetConfigVersion(_ context.Context, version int64) (*Configuration, error) {
+	data, err := c.history.read(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.parse(data)
+}
+
+func (c ConfigProviderFromPath) RestoreConfigVersion(ctx context.Context, version int64) error {
+	return c.history.restore(ctx, version)
 }
@@ -0,0 +1,338 @@
+package sandwich
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configInterpolationPattern matches ${ENV_VAR} and ${ENV_VAR:-default}.
+var configInterpolationPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\}`)
+
+type configFormat int
+
+const (
+	configFormatJSON configFormat = iota
+	configFormatYAML
+)
+
+// configPlaceholder is a string in the config file that referenced environment variables.
+type configPlaceholder struct {
+	template string
+	resolved string
+}
+
+// ConfigProviderFromFile is a config provider that reads and writes JSON or YAML, chosen by the file extension.
+// Any string in the file can reference environment variables using ${ENV_VAR} or ${ENV_VAR:-default}.
+// When the config is saved, values that were read from the environment are written back as their placeholders
+// so secrets injected through the environment are never persisted.
+type ConfigProviderFromFile struct {
+	path    string
+	format  configFormat
+	history configHistory
+
+	placeholdersMu sync.RWMutex
+	placeholders   map[string]configPlaceholder
+}
+
+func NewConfigProviderFromFile(path string) *ConfigProviderFromFile {
+	format := configFormatJSON
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		format = configFormatYAML
+	}
+
+	return &ConfigProviderFromFile{
+		path:    path,
+		format:  format,
+		history: newConfigHistory(path),
+
+		placeholdersMu: sync.RWMutex{},
+		placeholders:   make(map[string]configPlaceholder),
+	}
+}
+
+func (c *ConfigProviderFromFile) GetConfig(_ context.Context) (*Configuration, error) {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	config, placeholders, err := c.parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.placeholdersMu.Lock()
+	c.placeholders = placeholders
+	c.placeholdersMu.Unlock()
+
+	slog.Debug("Loaded config", "path", c.path, "placeholders", len(placeholders))
+
+	return config, nil
+}
+
+// parse decodes and interpolates the contents of a config file.
+func (c *ConfigProviderFromFile) parse(data []byte) (*Configuration, map[string]configPlaceholder, error) {
+	tree, err := c.decode(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode config file: %w", err)
+	}
+
+	tree, placeholders, err := interpolateConfigNode(tree, os.LookupEnv)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to interpolate config file: %w", err)
+	}
+
+	// The tree is converted back to JSON so the json tags on Configuration are used for both formats.
+	jsonData, err := json.Marshal(tree)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal config file: %w", err)
+	}
+
+	var config Configuration
+	if err := json.Unmarshal(jsonData, &config); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal config file: %w", err)
+	}
+
+	return &config, placeholders, nil
+}
+
+func (c *ConfigProviderFromFile) SaveConfig(ctx context.Context, config *Configuration) error {
+	data, err := c.encode(config)
+	if err != nil {
+		return err
+	}
+
+	slog.Debug("Saving config", "path", c.path)
+
+	return c.history.save(ctx, data)
+}
+
+func (c *ConfigProviderFromFile) ListConfigVersions(_ context.Context) ([]ConfigVersion, error) {
+	return c.history.list()
+}
+
+// GetConfigVersion returns a previous version with environment variables resolved, without restoring it.
+func (c *ConfigProviderFromFile) GetConfigVersion(_ context.Context, version int64) (*Configuration, error) {
+	data, err := c.history.read(version)
+	if err != nil {
+		return nil, err
+	}
+
+	config, _, err := c.parse(data)
+
+	return config, err
+}
+
+// RestoreConfigVersion restores the file as it was saved, so environment variable placeholders are kept.
+func (c *ConfigProviderFromFile) RestoreConfigVersion(ctx context.Context, version int64) error {
+	return c.history.restore(ctx, version)
+}
+
+// encode marshals the config in the format of the file, restoring any environment variable placeholders.
+func (c *ConfigProviderFromFile) encode(config *Configuration) ([]byte, error) {
+	jsonData, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+
+	var tree any
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, fmt.Errorf("failed to decode config: %w", err)
+	}
+
+	c.placeholdersMu.RLock()
+	tree = restoreConfigPlaceholders(tree, "", c.placeholders)
+	c.placeholdersMu.RUnlock()
+
+	switch c.format {
+	case configFormatYAML:
+		data, err := yaml.Marshal(tree)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal config: %w", err)
+		}
+
+		return data, nil
+	case configFormatJSON:
+		data, err := json.MarshalIndent(tree, "", "    ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal config: %w", err)
+		}
+
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("unknown config format %d", c.format)
+}
+
+// decode unmarshals the file into a generic tree.
+func (c *ConfigProviderFromFile) decode(data []byte) (any, error) {
+	var tree any
+
+	switch c.format {
+	case configFormatYAML:
+		if err := yaml.Unmarshal(data, &tree); err != nil {
+			return nil, err
+		}
+	case configFormatJSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+
+		if err := decoder.Decode(&tree); err != nil {
+			return nil, err
+		}
+	}
+
+	return tree, nil
+}
+
+// interpolateConfigNode resolves environment variable references in every string of a tree.
+// It returns the resolved tree and the placeholders that were resolved, keyed by their path relative to node.
+func interpolateConfigNode(node any, lookup func(string) (string, bool)) (any, map[string]configPlaceholder, error) {
+	placeholders := make(map[string]configPlaceholder)
+
+	switch value := node.(type) {
+	case string:
+		resolved, err := interpolateConfigString(value, lookup)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if resolved != value {
+			placeholders[""] = configPlaceholder{template: value, resolved: resolved}
+		}
+
+		return resolved, placeholders, nil
+	case map[string]any:
+		for key, child := range value {
+			resolved, childPlaceholders, err := interpolateConfigNode(child, lookup)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s: %w", key, err)
+			}
+
+			value[key] = resolved
+
+			for path, placeholder := range childPlaceholders {
+				placeholders["."+key+path] = placeholder
+			}
+		}
+
+		return value, placeholders, nil
+	case []any:
+		for index, child := range value {
+			resolved, childPlaceholders, err := interpolateConfigNode(child, lookup)
+			if err != nil {
+				return nil, nil, fmt.Errorf("[%d]: %w", index, err)
+			}
+
+			value[index] = resolved
+
+			// The key is computed after resolving, so it matches the key computed from the saved config.
+			elementKey := configElementKey(resolved, index)
+
+			for path, placeholder := range childPlaceholders {
+				placeholders["["+elementKey+"]"+path] = placeholder
+			}
+		}
+
+		return value, placeholders, nil
+	}
+
+	return node, placeholders, nil
+}
+
+// restoreConfigPlaceholders replaces strings that still match their resolved value with their placeholder.
+// Numbers are converted so they are written as numbers in both formats.
+func restoreConfigPlaceholders(node any, path string, placeholders map[string]configPlaceholder) any {
+	switch value := node.(type) {
+	case string:
+		if placeholder, ok := placeholders[path]; ok && placeholder.resolved == value {
+			return placeholder.template
+		}
+
+		return value
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		}
+
+		if f, err := value.Float64(); err == nil {
+			return f
+		}
+
+		return value.String()
+	case map[string]any:
+		for key, child := range value {
+			value[key] = restoreConfigPlaceholders(child, path+"."+key, placeholders)
+		}
+
+		return value
+	case []any:
+		for index, child := range value {
+			value[index] = restoreConfigPlaceholders(child, path+"["+configElementKey(child, index)+"]", placeholders)
+		}
+
+		return value
+	}
+
+	return node
+}
+
+// interpolateConfigString resolves every environment variable reference in a string.
+func interpolateConfigString(value string, lookup func(string) (string, bool)) (string, error) {
+	if !strings.Contains(value, "${") {
+		return value, nil
+	}
+
+	var missing []string
+
+	resolved := configInterpolationPattern.ReplaceAllStringFunc(value, func(match string) string {
+		groups := configInterpolationPattern.FindStringSubmatch(match)
+
+		if env, ok := lookup(groups[1]); ok {
+			return env
+		}
+
+		// Only use the default if the :- syntax was used, so ${ENV_VAR:-} can default to an empty string.
+		if strings.Contains(match, ":-") {
+			return groups[2]
+		}
+
+		missing = append(missing, groups[1])
+
+		return match
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrConfigurationMissingEnvironment, strings.Join(missing, ", "))
+	}
+
+	return resolved, nil
+}
+
+// configElementKey returns the key used for an array element in placeholder paths. Applications are keyed
+// by their identifier so placeholders follow them when the list is reordered.
+func configElementKey(element any, index int) string {
+	if object, ok := element.(map[string]any); ok {
+		if identifier, ok := object["application_identifier"].(string); ok && identifier != "" {
+			return identifier
+		}
+	}
+
+	return strconv.Itoa(index)
+}
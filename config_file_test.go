@@ -0,0 +1,103 @@
+package sandwich_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+const testYAMLConfig = `sandwich:
+    node_count: 1
+    node_id: 0
+applications:
+    - application_identifier: example-application
+      bot_token: ${SANDWICH_TEST_BOT_TOKEN}
+      display_name: ${SANDWICH_TEST_DISPLAY_NAME:-Example Application}
+      shard_count: 1
+`
+
+func TestConfigProviderFromFileInterpolation(t *testing.T) {
+	t.Setenv("SANDWICH_TEST_BOT_TOKEN", "secret-token")
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(testYAMLConfig), 0o600))
+
+	provider := sandwich.NewConfigProviderFromFile(path)
+
+	config, err := provider.GetConfig(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, config.Applications, 1)
+	assert.Equal(t, "secret-token", config.Applications[0].BotToken)
+	assert.Equal(t, "Example Application", config.Applications[0].DisplayName)
+	assert.Equal(t, int32(1), config.Applications[0].ShardCount)
+
+	config.Applications[0].ClientName = "example-client"
+
+	assert.NoError(t, provider.SaveConfig(context.Background(), config))
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "${SANDWICH_TEST_BOT_TOKEN}")
+	assert.Contains(t, string(data), "${SANDWICH_TEST_DISPLAY_NAME:-Example Application}")
+	assert.Contains(t, string(data), "client_name: example-client")
+	assert.NotContains(t, string(data), "secret-token")
+
+	// Values that were changed after loading are written as-is.
+	config.Applications[0].BotToken = "new-token"
+
+	assert.NoError(t, provider.SaveConfig(context.Background(), config))
+
+	data, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "bot_token: new-token")
+}
+
+func TestConfigProviderFromFileMissingEnvironment(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"applications": [{"bot_token": "${SANDWICH_TEST_UNSET_VARIABLE}"}]}`), 0o600))
+
+	_, err := sandwich.NewConfigProviderFromFile(path).GetConfig(context.Background())
+	assert.ErrorIs(t, err, sandwich.ErrConfigurationMissingEnvironment)
+}
+
+func TestRollbackConfigRejectsInvalidVersion(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	// The file starts out invalid, so the initial version recorded by the first save is invalid too.
+	assert.NoError(t, os.WriteFile(path, []byte("applications: []\n"), 0o600))
+
+	provider := sandwich.NewConfigProviderFromFile(path)
+	assert.NoError(t, provider.SaveConfig(context.Background(), &sandwich.Configuration{
+		Sandwich: &sandwich.DaemonConfiguration{NodeCount: 1},
+	}))
+
+	saved, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	versions, err := provider.ListConfigVersions(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), provider, nil, nil, nil, nil, nil, nil)
+
+	_, err = sandwichInstance.RollbackConfig(context.Background(), versions[0].Version)
+	assert.ErrorIs(t, err, sandwich.ErrConfigurationMissingField)
+
+	// Neither the file nor the history are changed.
+	current, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, string(saved), string(current))
+
+	versions, err = provider.ListConfigVersions(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+}
@@ -0,0 +1,308 @@
+package sandwich
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultConfigHistoryLimit is the number of previous config versions that are kept.
+var DefaultConfigHistoryLimit = 10
+
+const configHistoryIndex = "index.json"
+
+// ConfigVersion describes a saved version of the config.
+type ConfigVersion struct {
+	Version   int64     `json:"version"`
+	Timestamp time.Time `json:"timestamp"`
+	// Reason is what caused the config to be saved, such as the RPC that was called.
+	Reason string `json:"reason"`
+	Size   int64  `json:"size"`
+}
+
+// ConfigVersionProvider is implemented by config providers that keep a history of saved versions.
+type ConfigVersionProvider interface {
+	ListConfigVersions(ctx context.Context) ([]ConfigVersion, error)
+	// GetConfigVersion returns a previous version without restoring it.
+	GetConfigVersion(ctx context.Context, version int64) (*Configuration, error)
+	// RestoreConfigVersion replaces the current config with a previous version.
+	// The restore itself is recorded as a new version.
+	RestoreConfigVersion(ctx context.Context, version int64) error
+}
+
+type configSaveReasonKey struct{}
+
+// WithConfigSaveReason returns a context that records why the config is being saved.
+func WithConfigSaveReason(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, configSaveReasonKey{}, reason)
+}
+
+func configSaveReason(ctx context.Context) string {
+	if reason, ok := ctx.Value(configSaveReasonKey{}).(string); ok {
+		return reason
+	}
+
+	return "unknown"
+}
+
+// RollbackConfig restores a previous version of the config and reconciles running applications with it.
+func (sandwich *Sandwich) RollbackConfig(ctx context.Context, version int64) (*ConfigurationChangeReport, error) {
+	versionProvider, ok := sandwich.configProvider.(ConfigVersionProvider)
+	if !ok {
+		return nil, ErrConfigVersioningUnsupported
+	}
+
+	sandwich.Logger.Info("Rolling back configuration", "version", version)
+
+	// The version is validated before it is restored, so an invalid version never replaces the file on disk.
+	config, err := versionProvider.GetConfigVersion(ctx, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config version: %w", err)
+	}
+
+	if err := ValidateConfiguration(config); err != nil {
+		return nil, fmt.Errorf("config version %d is invalid: %w", version, err)
+	}
+
+	ctx = WithConfigSaveReason(ctx, fmt.Sprintf("RollbackConfig (version %d)", version))
+
+	if err := versionProvider.RestoreConfigVersion(ctx, version); err != nil {
+		return nil, fmt.Errorf("failed to restore config version: %w", err)
+	}
+
+	return sandwich.ReloadConfiguration(ctx, false)
+}
+
+// writeFileAtomic writes a file by writing to a temporary file in the same directory and renaming it
+// over the destination, so the file is never left partially written.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+
+	file, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	tempPath := file.Name()
+
+	// Remove the temporary file if anything fails before the rename.
+	defer os.Remove(tempPath)
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+
+		return fmt.Errorf("failed to sync temporary file: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+
+	if err := os.Chmod(tempPath, perm); err != nil {
+		return fmt.Errorf("failed to chmod temporary file: %w", err)
+	}
+
+	if err := os.Rename(tempPath, path); err != nil {
+		return fmt.Errorf("failed to rename temporary file: %w", err)
+	}
+
+	// Sync the directory so the rename is durable. Not all platforms support this.
+	if dirFile, err := os.Open(dir); err == nil {
+		_ = dirFile.Sync()
+		dirFile.Close()
+	}
+
+	return nil
+}
+
+// configHistory stores previous versions of a config file in a directory next to it.
+type configHistory struct {
+	path  string
+	dir   string
+	limit int
+}
+
+// Saves and restores of the same file may come from multiple providers, so history is locked globally.
+var configHistoryMu sync.Mutex
+
+func newConfigHistory(path string) configHistory {
+	return configHistory{
+		path:  path,
+		dir:   path + ".history",
+		limit: DefaultConfigHistoryLimit,
+	}
+}
+
+// save atomically writes data to the config file and records it as a new version.
+func (h configHistory) save(ctx context.Context, data []byte) error {
+	configHistoryMu.Lock()
+	defer configHistoryMu.Unlock()
+
+	return h.saveLocked(configSaveReason(ctx), data)
+}
+
+func (h configHistory) saveLocked(reason string, data []byte) error {
+	versions, err := h.readIndex()
+	if err != nil {
+		return err
+	}
+
+	// Record the file as it was before the first save so it can be restored.
+	if len(versions) == 0 {
+		if existing, err := os.ReadFile(h.path); err == nil {
+			versions, err = h.record(versions, "initial", existing)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := writeFileAtomic(h.path, data, 0o600); err != nil {
+		return err
+	}
+
+	versions, err = h.record(versions, reason, data)
+	if err != nil {
+		return err
+	}
+
+	return h.prune(versions)
+}
+
+func (h configHistory) list() ([]ConfigVersion, error) {
+	configHistoryMu.Lock()
+	defer configHistoryMu.Unlock()
+
+	return h.readIndex()
+}
+
+// read returns the contents of a previous version.
+func (h configHistory) read(version int64) ([]byte, error) {
+	configHistoryMu.Lock()
+	defer configHistoryMu.Unlock()
+
+	return h.readLocked(version)
+}
+
+func (h configHistory) readLocked(version int64) ([]byte, error) {
+	versions, err := h.readIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.ContainsFunc(versions, func(v ConfigVersion) bool { return v.Version == version }) {
+		return nil, fmt.Errorf("%w: %d", ErrConfigVersionNotFound, version)
+	}
+
+	data, err := os.ReadFile(h.versionPath(version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config version: %w", err)
+	}
+
+	return data, nil
+}
+
+// restore writes a previous version back to the config file.
+func (h configHistory) restore(ctx context.Context, version int64) error {
+	configHistoryMu.Lock()
+	defer configHistoryMu.Unlock()
+
+	data, err := h.readLocked(version)
+	if err != nil {
+		return err
+	}
+
+	return h.saveLocked(configSaveReason(ctx), data)
+}
+
+// record writes data as the next version and returns the updated index.
+func (h configHistory) record(versions []ConfigVersion, reason string, data []byte) ([]ConfigVersion, error) {
+	if err := os.MkdirAll(h.dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create config history directory: %w", err)
+	}
+
+	version := ConfigVersion{
+		Version:   1,
+		Timestamp: time.Now().UTC(),
+		Reason:    reason,
+		Size:      int64(len(data)),
+	}
+
+	if len(versions) > 0 {
+		version.Version = versions[len(versions)-1].Version + 1
+	}
+
+	if err := writeFileAtomic(h.versionPath(version.Version), data, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write config version: %w", err)
+	}
+
+	versions = append(versions, version)
+
+	return versions, h.writeIndex(versions)
+}
+
+// prune removes the oldest versions above the history limit.
+func (h configHistory) prune(versions []ConfigVersion) error {
+	if h.limit <= 0 || len(versions) <= h.limit {
+		return nil
+	}
+
+	removed := versions[:len(versions)-h.limit]
+	versions = versions[len(versions)-h.limit:]
+
+	if err := h.writeIndex(versions); err != nil {
+		return err
+	}
+
+	for _, version := range removed {
+		if err := os.Remove(h.versionPath(version.Version)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove config version: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (h configHistory) readIndex() ([]ConfigVersion, error) {
+	data, err := os.ReadFile(filepath.Join(h.dir, configHistoryIndex))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return make([]ConfigVersion, 0), nil
+		}
+
+		return nil, fmt.Errorf("failed to read config history: %w", err)
+	}
+
+	var versions []ConfigVersion
+	if err := json.Unmarshal(data, &versions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config history: %w", err)
+	}
+
+	return versions, nil
+}
+
+func (h configHistory) writeIndex(versions []ConfigVersion) error {
+	data, err := json.Marshal(versions)
+	if err != nil {
+		return fmt.Errorf("failed to marshal config history: %w", err)
+	}
+
+	return writeFileAtomic(filepath.Join(h.dir, configHistoryIndex), data, 0o600)
+}
+
+func (h configHistory) versionPath(version int64) string {
+	return filepath.Join(h.dir, fmt.Sprintf("%d%s", version, filepath.Ext(h.path)))
+}
@@ -0,0 +1,239 @@
+package sandwich
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+type ConfigurationChangeAction string
+
+const (
+	// ConfigurationChangeAdded is an application that is new and has been created.
+	ConfigurationChangeAdded ConfigurationChangeAction = "added"
+	// ConfigurationChangeRemoved is an application that no longer exists and has been stopped and deleted.
+	ConfigurationChangeRemoved ConfigurationChangeAction = "removed"
+	// ConfigurationChangeRestarted is an application whose identity changed and has been re-initialized.
+	ConfigurationChangeRestarted ConfigurationChangeAction = "restarted"
+	// ConfigurationChangeResharded is an application whose sharding changed and has had its shards recreated.
+	ConfigurationChangeResharded ConfigurationChangeAction = "resharded"
+	// ConfigurationChangeUpdated is an application whose configuration was swapped in place.
+	ConfigurationChangeUpdated ConfigurationChangeAction = "updated"
+)
+
+// Fields that require the application to be re-initialized, as they are used when fetching
+// the gateway, creating the producer or identifying.
+var configurationRestartFields = []string{
+	"bot_token",
+	"client_name",
+	"client_name_uses_random_suffix",
+	"intents",
+	"producers",
+	"producer_routes",
+	"producer_retry",
+	"producer_spool",
+}
+
+// Fields that require the shards of the application to be recreated.
+var configurationReshardFields = []string{
+	"auto_sharded",
+	"shard_count",
+	"shard_ids",
+}
+
+type ConfigurationChange struct {
+	ApplicationIdentifier string                    `json:"application_identifier"`
+	Action                ConfigurationChangeAction `json:"action"`
+	Fields                []string                  `json:"fields,omitempty"`
+	Error                 string                    `json:"error,omitempty"`
+}
+
+// ConfigurationChangeReport describes the changes between the running and the new configuration.
+type ConfigurationChangeReport struct {
+	DryRun  bool                  `json:"dry_run"`
+	Changes []ConfigurationChange `json:"changes"`
+	// Error is set when the configuration could not be reloaded.
+	Error string `json:"error,omitempty"`
+}
+
+// ReloadConfiguration fetches the configuration from the config provider and reconciles running applications
+// with it. New applications are added, removed applications are stopped and deleted and changed applications
+// are restarted, resharded or updated in place. When dryRun is true, the changes are only reported.
+// Only one reload runs at a time.
+func (sandwich *Sandwich) ReloadConfiguration(ctx context.Context, dryRun bool) (*ConfigurationChangeReport, error) {
+	sandwich.reconcileMu.Lock()
+	defer sandwich.reconcileMu.Unlock()
+
+	sandwich.Logger.Info("Reloading configuration", "dry_run", dryRun)
+
+	config, err := sandwich.configProvider.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	if err := ValidateConfiguration(config); err != nil {
+		return nil, err
+	}
+
+	report := sandwich.diffConfiguration(config)
+	report.DryRun = dryRun
+
+	if dryRun {
+		return report, nil
+	}
+
+	sandwich.Config.Store(config)
+
+	sandwich.reconcileApplications(ctx, config, report)
+
+	err = sandwich.Broadcast(SandwichEventConfigUpdate, report)
+	if err != nil {
+		sandwich.Logger.Error("Failed to broadcast configuration reload", "error", err)
+	}
+
+	return report, nil
+}
+
+// diffConfiguration compares the running applications against a new configuration.
+func (sandwich *Sandwich) diffConfiguration(config *Configuration) *ConfigurationChangeReport {
+	report := &ConfigurationChangeReport{
+		DryRun:  false,
+		Changes: make([]ConfigurationChange, 0),
+	}
+
+	nodeChanged := false
+
+	if currentConfig := sandwich.Config.Load(); currentConfig != nil && currentConfig.Sandwich != nil && config.Sandwich != nil {
+		nodeChanged = currentConfig.Sandwich.NodeCount != config.Sandwich.NodeCount ||
+			currentConfig.Sandwich.NodeID != config.Sandwich.NodeID
+	}
+
+	identifiers := make(map[string]bool, len(config.Applications))
+
+	for _, applicationConfig := range config.Applications {
+		identifiers[applicationConfig.ApplicationIdentifier] = true
+
+		application, ok := sandwich.Applications.Load(applicationConfig.ApplicationIdentifier)
+		if !ok {
+			report.Changes = append(report.Changes, ConfigurationChange{
+				ApplicationIdentifier: applicationConfig.ApplicationIdentifier,
+				Action:                ConfigurationChangeAdded,
+			})
+
+			continue
+		}
+
+		fields := diffApplicationConfiguration(application.Configuration.Load(), applicationConfig)
+
+		if nodeChanged {
+			fields = append(fields, "sandwich.node_count", "sandwich.node_id")
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		action := ConfigurationChangeUpdated
+
+		switch {
+		case containsAny(fields, configurationRestartFields):
+			action = ConfigurationChangeRestarted
+		case nodeChanged || containsAny(fields, configurationReshardFields):
+			action = ConfigurationChangeResharded
+		}
+
+		report.Changes = append(report.Changes, ConfigurationChange{
+			ApplicationIdentifier: applicationConfig.ApplicationIdentifier,
+			Action:                action,
+			Fields:                fields,
+		})
+	}
+
+	sandwich.Applications.Range(func(identifier string, _ *Application) bool {
+		if !identifiers[identifier] {
+			report.Changes = append(report.Changes, ConfigurationChange{
+				ApplicationIdentifier: identifier,
+				Action:                ConfigurationChangeRemoved,
+			})
+		}
+
+		return true
+	})
+
+	return report
+}
+
+// reconcileApplications applies the changes in a report to the running applications.
+func (sandwich *Sandwich) reconcileApplications(ctx context.Context, config *Configuration, report *ConfigurationChangeReport) {
+	applicationConfigs := make(map[string]*ApplicationConfiguration, len(config.Applications))
+
+	for _, applicationConfig := range config.Applications {
+		applicationConfigs[applicationConfig.ApplicationIdentifier] = applicationConfig
+	}
+
+	for i := range report.Changes {
+		change := &report.Changes[i]
+		applicationConfig := applicationConfigs[change.ApplicationIdentifier]
+
+		var err error
+
+		switch change.Action {
+		case ConfigurationChangeAdded:
+			err = sandwich.validateApplicationConfig(applicationConfig)
+			if err == nil {
+				_, err = sandwich.AddApplication(ctx, applicationConfig)
+			}
+		case ConfigurationChangeRemoved:
+			if application, ok := sandwich.Applications.LoadAndDelete(change.ApplicationIdentifier); ok {
+				err = application.Stop(ctx)
+			}
+		case ConfigurationChangeRestarted, ConfigurationChangeResharded:
+			if application, ok := sandwich.Applications.Load(change.ApplicationIdentifier); ok {
+				err = application.Restart(ctx, applicationConfig)
+			}
+		case ConfigurationChangeUpdated:
+			if application, ok := sandwich.Applications.Load(change.ApplicationIdentifier); ok {
+				application.UpdateConfiguration(applicationConfig)
+			}
+		}
+
+		if err != nil {
+			sandwich.Logger.Error("Failed to reconcile application", "application_identifier", change.ApplicationIdentifier, "action", change.Action, "error", err)
+
+			change.Error = err.Error()
+		} else {
+			sandwich.Logger.Info("Reconciled application", "application_identifier", change.ApplicationIdentifier, "action", change.Action, "fields", change.Fields)
+		}
+	}
+}
+
+// diffApplicationConfiguration returns the JSON names of the fields that differ between two application configurations.
+func diffApplicationConfiguration(oldConfig, newConfig *ApplicationConfiguration) []string {
+	fields := make([]string, 0)
+
+	oldValue := reflect.ValueOf(oldConfig).Elem()
+	newValue := reflect.ValueOf(newConfig).Elem()
+
+	for i := range oldValue.NumField() {
+		if reflect.DeepEqual(oldValue.Field(i).Interface(), newValue.Field(i).Interface()) {
+			continue
+		}
+
+		name, _, _ := strings.Cut(oldValue.Type().Field(i).Tag.Get("json"), ",")
+		fields = append(fields, name)
+	}
+
+	return fields
+}
+
+func containsAny(values, targets []string) bool {
+	for _, target := range targets {
+		if slices.Contains(values, target) {
+			return true
+		}
+	}
+
+	return false
+}
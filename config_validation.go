@@ -0,0 +1,429 @@
+package sandwich
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/WelcomerTeam/Discord/discord"
+)
+
+// ValidIntents contains every intent bit that is known to the gateway.
+var ValidIntents = int32(discord.IntentGuilds |
+	discord.IntentGuildMembers |
+	discord.IntentGuildModeration |
+	discord.IntentGuildEmojis |
+	discord.IntentGuildIntegrations |
+	discord.IntentGuildWebhooks |
+	discord.IntentGuildInvites |
+	discord.IntentGuildVoiceStates |
+	discord.IntentGuildPresences |
+	discord.IntentGuildMessages |
+	discord.IntentGuildMessageReactions |
+	discord.IntentGuildMessageTyping |
+	discord.IntentDirectMessages |
+	discord.IntentDirectMessageReactions |
+	discord.IntentDirectMessageTyping |
+	discord.IntentMessageContent |
+	discord.IntentGuildScheduledEvents |
+	discord.IntentAutoModerationConfiguration |
+	discord.IntentAutoModerationExecution |
+	discord.IntentGuildMessagePolls |
+	discord.IntentDirectMessagePolls)
+
+var validPresenceStatuses = []string{
+	"",
+	string(discord.PresenceStatusOnline),
+	string(discord.PresenceStatusIdle),
+	string(discord.PresenceStatusDND),
+	string(discord.PresenceStatusOffline),
+	"invisible",
+}
+
+// The highest activity type, competing.
+const maxActivityType = discord.ActivityType(5)
+
+// ConfigurationError is a validation error for a single field in a Configuration.
+type ConfigurationError struct {
+	Field string
+	Err   error
+}
+
+func (e ConfigurationError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+func (e ConfigurationError) Unwrap() error {
+	return e.Err
+}
+
+// ConfigurationErrors is every validation error found in a Configuration.
+type ConfigurationErrors []ConfigurationError
+
+func (e ConfigurationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+
+	for _, configurationError := range e {
+		messages = append(messages, configurationError.Error())
+	}
+
+	return "invalid configuration: " + strings.Join(messages, "; ")
+}
+
+func (e ConfigurationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+
+	for _, configurationError := range e {
+		errs = append(errs, configurationError)
+	}
+
+	return errs
+}
+
+func (e *ConfigurationErrors) add(field string, err error) {
+	*e = append(*e, ConfigurationError{Field: field, Err: err})
+}
+
+func (e *ConfigurationErrors) addf(field, format string, args ...any) {
+	e.add(field, fmt.Errorf(format, args...))
+}
+
+func (e ConfigurationErrors) orNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// ValidateConfiguration validates a configuration and returns ConfigurationErrors
+// containing every problem that was found.
+func ValidateConfiguration(config *Configuration) error {
+	var errs ConfigurationErrors
+
+	if config == nil {
+		errs.add("", ErrConfigurationMissingField)
+
+		return errs
+	}
+
+	if config.Sandwich == nil {
+		errs.add("sandwich", ErrConfigurationMissingField)
+	} else {
+		if config.Sandwich.NodeCount < 0 {
+			errs.addf("sandwich.node_count", "must not be negative, got %d", config.Sandwich.NodeCount)
+		}
+
+		if config.Sandwich.NodeID < 0 {
+			errs.addf("sandwich.node_id", "must not be negative, got %d", config.Sandwich.NodeID)
+		}
+
+		if config.Sandwich.NodeCount > 0 && config.Sandwich.NodeID >= config.Sandwich.NodeCount {
+			errs.addf("sandwich.node_id", "must be lower than node_count (%d), got %d", config.Sandwich.NodeCount, config.Sandwich.NodeID)
+		}
+	}
+
+	identifiers := make(map[string]int, len(config.Applications))
+	deadLetterPaths := make(map[string]int)
+	spoolDirectories := make(map[string]int)
+
+	for index, applicationConfig := range config.Applications {
+		path := fmt.Sprintf("applications[%d]", index)
+
+		if applicationConfig == nil {
+			errs.add(path, ErrConfigurationMissingField)
+
+			continue
+		}
+
+		errs = append(errs, validateApplicationConfiguration(path, applicationConfig)...)
+
+		if deadLetterPath := applicationConfig.ProducerRetry.DeadLetterPath; deadLetterPath != "" {
+			if existingIndex, ok := deadLetterPaths[deadLetterPath]; ok {
+				errs.addf(path+".producer_retry.dead_letter_path", "%q is also used by applications[%d]", deadLetterPath, existingIndex)
+			} else {
+				deadLetterPaths[deadLetterPath] = index
+			}
+		}
+
+		if spoolDirectory := applicationConfig.ProducerSpool.Directory; spoolDirectory != "" {
+			if existingIndex, ok := spoolDirectories[spoolDirectory]; ok {
+				errs.addf(path+".producer_spool.directory", "%q is also used by applications[%d]", spoolDirectory, existingIndex)
+			} else {
+				spoolDirectories[spoolDirectory] = index
+			}
+		}
+
+		if applicationConfig.ApplicationIdentifier == "" {
+			continue
+		}
+
+		if existingIndex, ok := identifiers[applicationConfig.ApplicationIdentifier]; ok {
+			errs.add(path+".application_identifier", fmt.Errorf("%w: %q is also used by applications[%d]",
+				ErrApplicationIdentifierExists, applicationConfig.ApplicationIdentifier, existingIndex))
+		} else {
+			identifiers[applicationConfig.ApplicationIdentifier] = index
+		}
+	}
+
+	return errs.orNil()
+}
+
+// validateApplicationConfiguration validates a single application configuration. Field paths are prefixed with path.
+func validateApplicationConfiguration(path string, applicationConfig *ApplicationConfiguration) ConfigurationErrors {
+	var errs ConfigurationErrors
+
+	if applicationConfig.ApplicationIdentifier == "" {
+		errs.add(path+".application_identifier", ErrApplicationMissingIdentifier)
+	}
+
+	if applicationConfig.BotToken == "" {
+		errs.add(path+".bot_token", ErrApplicationMissingBotToken)
+	} else if IsSecretReference(applicationConfig.BotToken) {
+		if _, name, _ := strings.Cut(applicationConfig.BotToken, ":"); strings.TrimSpace(name) == "" {
+			errs.addf(path+".bot_token", "secret reference %q is missing a name", applicationConfig.BotToken)
+		}
+	}
+
+	if applicationConfig.Intents < 0 || applicationConfig.Intents&^ValidIntents != 0 {
+		errs.addf(path+".intents", "contains unknown intent bits %d", applicationConfig.Intents&^ValidIntents)
+	}
+
+	// Sharding
+
+	if applicationConfig.ShardCount < 0 {
+		errs.addf(path+".shard_count", "must not be negative, got %d", applicationConfig.ShardCount)
+	} else if !applicationConfig.AutoSharded && applicationConfig.ShardCount == 0 {
+		errs.addf(path+".shard_count", "must be set when auto_sharded is disabled")
+	}
+
+	if applicationConfig.ShardIDs != "" {
+		shardIDs, err := ParseShardIDs(applicationConfig.ShardIDs)
+		if err != nil {
+			errs.add(path+".shard_ids", err)
+		} else if !applicationConfig.AutoSharded && applicationConfig.ShardCount > 0 {
+			for _, shardID := range shardIDs {
+				if shardID >= applicationConfig.ShardCount {
+					errs.addf(path+".shard_ids", "shard %d is out of range for shard_count %d", shardID, applicationConfig.ShardCount)
+
+					break
+				}
+			}
+		}
+	}
+
+	// Blacklists
+
+	for index, eventType := range applicationConfig.EventBlacklist {
+		if _, ok := dispatchHandlers[eventType]; !ok {
+			errs.addf(fmt.Sprintf("%s.event_blacklist[%d]", path, index), "unknown event %q", eventType)
+		}
+	}
+
+	for index, eventType := range applicationConfig.ProduceBlacklist {
+		if _, ok := dispatchHandlers[eventType]; !ok {
+			errs.addf(fmt.Sprintf("%s.produce_blacklist[%d]", path, index), "unknown event %q", eventType)
+		}
+	}
+
+	// Guild lists
+
+	for index, guildID := range applicationConfig.GuildAllowList {
+		if slices.Contains(applicationConfig.GuildDenyList, guildID) {
+			errs.addf(fmt.Sprintf("%s.guild_allow_list[%d]", path, index), "guild %d is also in guild_deny_list", guildID)
+		}
+	}
+
+	// Event rules
+
+	_, ruleErrs := compileEventRules(path+".event_rules", applicationConfig.EventRules)
+	errs = append(errs, ruleErrs...)
+
+	// Payload projections
+
+	for eventType, projection := range applicationConfig.PayloadProjections {
+		projectionPath := path + ".payload_projections." + eventType
+
+		if _, ok := dispatchHandlers[eventType]; !ok {
+			errs.addf(projectionPath, "unknown event %q", eventType)
+		}
+
+		if _, err := CompilePayloadProjection(projection); err != nil {
+			errs.add(projectionPath, err)
+		}
+	}
+
+	// Producers
+
+	producerNames := map[string]bool{DefaultProducerName: true}
+
+	for index, producer := range applicationConfig.Producers {
+		producerPath := fmt.Sprintf("%s.producers[%d].name", path, index)
+
+		switch {
+		case producer.Name == "":
+			errs.add(producerPath, ErrConfigurationMissingField)
+		case producerNames[producer.Name]:
+			errs.addf(producerPath, "duplicate producer name %q", producer.Name)
+		default:
+			producerNames[producer.Name] = true
+		}
+
+		// Options of the built in producer types are validated here, other types validate their own when created.
+		var options interface{ validate() error }
+
+		switch producer.Type {
+		case WebhookProducerType:
+			options = &WebhookProducerConfiguration{}
+		case FileProducerType:
+			options = &FileProducerConfiguration{}
+		case SocketProducerType:
+			options = &SocketProducerConfiguration{}
+		}
+
+		if options != nil {
+			optionsPath := fmt.Sprintf("%s.producers[%d].options", path, index)
+
+			if err := json.Unmarshal(producer.Options, options); err != nil {
+				errs.addf(optionsPath, "invalid %s options: %v", producer.Type, err)
+			} else if err := options.validate(); err != nil {
+				errs.add(optionsPath, err)
+			}
+		}
+	}
+
+	for index, route := range applicationConfig.ProducerRoutes {
+		routePath := fmt.Sprintf("%s.producer_routes[%d]", path, index)
+
+		if route.Name == "" {
+			errs.add(routePath+".name", ErrConfigurationMissingField)
+		}
+
+		if len(route.Events) == 0 {
+			errs.add(routePath+".events", ErrConfigurationMissingField)
+		}
+
+		for eventIndex, pattern := range route.Events {
+			eventPath := fmt.Sprintf("%s.events[%d]", routePath, eventIndex)
+
+			if !validEventPattern(pattern) {
+				errs.addf(eventPath, "invalid pattern %q", pattern)
+			} else if !strings.ContainsAny(pattern, "*?[\\") {
+				if _, ok := dispatchHandlers[pattern]; !ok {
+					errs.addf(eventPath, "unknown event %q", pattern)
+				}
+			}
+		}
+
+		if len(route.Producers) == 0 {
+			errs.add(routePath+".producers", ErrConfigurationMissingField)
+		}
+
+		for producerIndex, name := range route.Producers {
+			if !producerNames[name] {
+				errs.addf(fmt.Sprintf("%s.producers[%d]", routePath, producerIndex), "unknown producer %q", name)
+			}
+		}
+
+		if route.OnError != "" && route.OnError != ProducerRouteErrorFail && route.OnError != ProducerRouteErrorIgnore {
+			errs.addf(routePath+".on_error", "unknown policy %q, expected fail or ignore", route.OnError)
+		}
+	}
+
+	// Producer retries
+
+	retry := applicationConfig.ProducerRetry
+
+	if retry.MaxAttempts < 0 {
+		errs.addf(path+".producer_retry.max_attempts", "must not be negative, got %d", retry.MaxAttempts)
+	}
+
+	if retry.InitialBackoffMilliseconds < 0 {
+		errs.addf(path+".producer_retry.initial_backoff_milliseconds", "must not be negative, got %d", retry.InitialBackoffMilliseconds)
+	}
+
+	if retry.MaxBackoffMilliseconds < 0 {
+		errs.addf(path+".producer_retry.max_backoff_milliseconds", "must not be negative, got %d", retry.MaxBackoffMilliseconds)
+	}
+
+	if retry.DeadLetterMaxBytes < 0 {
+		errs.addf(path+".producer_retry.dead_letter_max_bytes", "must not be negative, got %d", retry.DeadLetterMaxBytes)
+	}
+
+	if retry.DeadLetterPath != "" && !retry.Enabled() {
+		errs.addf(path+".producer_retry.dead_letter_path", "must not be set when max_attempts is 0")
+	}
+
+	// Producer spool
+
+	spool := applicationConfig.ProducerSpool
+
+	for _, field := range []struct {
+		name  string
+		value int64
+	}{
+		{"segment_bytes", spool.SegmentBytes},
+		{"max_bytes", spool.MaxBytes},
+		{"fsync_interval_milliseconds", int64(spool.FsyncIntervalMilliseconds)},
+		{"pause_bytes", spool.PauseBytes},
+		{"resume_bytes", spool.ResumeBytes},
+	} {
+		if field.value < 0 {
+			errs.addf(path+".producer_spool."+field.name, "must not be negative, got %d", field.value)
+		}
+	}
+
+	switch spool.Fsync {
+	case "", SpoolFsyncAlways, SpoolFsyncInterval, SpoolFsyncNever:
+	default:
+		errs.addf(path+".producer_spool.fsync", "unknown policy %q, expected always, interval or never", spool.Fsync)
+	}
+
+	if spool.PauseBytes > 0 && spool.ResumeBytes > spool.PauseBytes {
+		errs.addf(path+".producer_spool.resume_bytes", "must not be more than pause_bytes (%d), got %d", spool.PauseBytes, spool.ResumeBytes)
+	}
+
+	if spool.MaxBytes > 0 && spool.PauseBytes > spool.MaxBytes {
+		errs.addf(path+".producer_spool.pause_bytes", "must not be more than max_bytes (%d), got %d", spool.MaxBytes, spool.PauseBytes)
+	}
+
+	// Presence
+
+	errs = append(errs, validatePresence(path+".default_presence", applicationConfig.DefaultPresence)...)
+
+	if rotation := applicationConfig.PresenceRotation; rotation.Enabled() {
+		if rotation.Interval() < MinimumPresenceRotationInterval {
+			errs.addf(path+".presence_rotation.interval_seconds", "must be at least %d, got %d",
+				int(MinimumPresenceRotationInterval.Seconds()), rotation.IntervalSeconds)
+		}
+
+		for index, presence := range rotation.Presences {
+			errs = append(errs, validatePresence(fmt.Sprintf("%s.presence_rotation.presences[%d]", path, index), presence)...)
+		}
+	}
+
+	return errs
+}
+
+func validatePresence(path string, presence discord.UpdateStatus) ConfigurationErrors {
+	var errs ConfigurationErrors
+
+	if !slices.Contains(validPresenceStatuses, presence.Status) {
+		errs.addf(path+".status", "unknown status %q, expected one of online, idle, dnd, invisible or offline", presence.Status)
+	}
+
+	for index, activity := range presence.Activities {
+		activityPath := fmt.Sprintf("%s.activities[%d]", path, index)
+
+		if activity.Name == "" {
+			errs.addf(activityPath+".name", "must be set")
+		}
+
+		if activity.Type < 0 || activity.Type > maxActivityType {
+			errs.addf(activityPath+".type", "unknown activity type %d", activity.Type)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,246 @@
+package sandwich
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultConfigWatchInterval = time.Second * 2
+	DefaultConfigWatchDebounce = time.Second * 5
+)
+
+// ConfigWatcher is implemented by config providers that can notify when the config has changed.
+// Sandwich will start watching when started and reload the configuration on every change.
+type ConfigWatcher interface {
+	Watch(ctx context.Context, onChange func(ctx context.Context))
+}
+
+// ConfigProviderWithWatcher wraps a file based config provider and watches the file for changes.
+// The file is polled by modification time and size and changes are confirmed by comparing a hash of
+// the contents, so it does not depend on platform specific file notifications.
+// A change is only reported once the file has not changed for the debounce duration.
+type ConfigProviderWithWatcher struct {
+	ConfigProvider
+
+	path     string
+	interval time.Duration
+	debounce time.Duration
+
+	stateMu sync.Mutex
+	modTime time.Time
+	size    int64
+	hash    []byte
+}
+
+func NewConfigProviderWithWatcher(configProvider ConfigProvider, path string, interval, debounce time.Duration) *ConfigProviderWithWatcher {
+	if interval <= 0 {
+		interval = DefaultConfigWatchInterval
+	}
+
+	if debounce < 0 {
+		debounce = DefaultConfigWatchDebounce
+	}
+
+	return &ConfigProviderWithWatcher{
+		ConfigProvider: configProvider,
+
+		path:     path,
+		interval: interval,
+		debounce: debounce,
+
+		stateMu: sync.Mutex{},
+	}
+}
+
+func (c *ConfigProviderWithWatcher) GetConfig(ctx context.Context) (*Configuration, error) {
+	config, err := c.ConfigProvider.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.snapshot()
+
+	return config, nil
+}
+
+func (c *ConfigProviderWithWatcher) SaveConfig(ctx context.Context, config *Configuration) error {
+	err := c.ConfigProvider.SaveConfig(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	// Record the saved file so our own writes do not trigger a reload.
+	c.snapshot()
+
+	return nil
+}
+
+func (c *ConfigProviderWithWatcher) ListConfigVersions(ctx context.Context) ([]ConfigVersion, error) {
+	versionProvider, ok := c.ConfigProvider.(ConfigVersionProvider)
+	if !ok {
+		return nil, ErrConfigVersioningUnsupported
+	}
+
+	return versionProvider.ListConfigVersions(ctx)
+}
+
+func (c *ConfigProviderWithWatcher) GetConfigVersion(ctx context.Context, version int64) (*Configuration, error) {
+	versionProvider, ok := c.ConfigProvider.(ConfigVersionProvider)
+	if !ok {
+		return nil, ErrConfigVersioningUnsupported
+	}
+
+	return versionProvider.GetConfigVersion(ctx, version)
+}
+
+func (c *ConfigProviderWithWatcher) RestoreConfigVersion(ctx context.Context, version int64) error {
+	versionProvider, ok := c.ConfigProvider.(ConfigVersionProvider)
+	if !ok {
+		return ErrConfigVersioningUnsupported
+	}
+
+	return versionProvider.RestoreConfigVersion(ctx, version)
+}
+
+// Watch polls the file until the context is cancelled, calling onChange when the contents have changed.
+func (c *ConfigProviderWithWatcher) Watch(ctx context.Context, onChange func(ctx context.Context)) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	var pendingSince time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		changed, err := c.poll()
+		if err != nil {
+			slog.Warn("Failed to poll config file", "path", c.path, "error", err)
+
+			continue
+		}
+
+		now := time.Now()
+
+		switch {
+		case changed:
+			// Restart the debounce window every time the file changes.
+			pendingSince = now
+		case pendingSince.IsZero():
+			continue
+		}
+
+		if now.Sub(pendingSince) < c.debounce {
+			continue
+		}
+
+		pendingSince = time.Time{}
+
+		slog.Info("Config file changed", "path", c.path)
+
+		onChange(ctx)
+	}
+}
+
+// poll returns true if the contents of the file have changed since the last poll.
+func (c *ConfigProviderWithWatcher) poll() (bool, error) {
+	info, err := os.Stat(c.path)
+	if err != nil {
+		// The file may be briefly missing while it is being replaced.
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+
+	if info.ModTime().Equal(c.modTime) && info.Size() == c.size {
+		return false, nil
+	}
+
+	hash, err := hashFile(c.path)
+	if err != nil {
+		// The stat is not recorded, so the next poll hashes the file again.
+		return false, err
+	}
+
+	c.modTime = info.ModTime()
+	c.size = info.Size()
+
+	// Touching the file without changing it is not a change.
+	if bytes.Equal(hash, c.hash) {
+		return false, nil
+	}
+
+	c.hash = hash
+
+	return true, nil
+}
+
+// snapshot records the current state of the file.
+func (c *ConfigProviderWithWatcher) snapshot() {
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return
+	}
+
+	hash, err := hashFile(c.path)
+	if err != nil {
+		return
+	}
+
+	c.stateMu.Lock()
+	c.modTime = info.ModTime()
+	c.size = info.Size()
+	c.hash = hash
+	c.stateMu.Unlock()
+}
+
+func hashFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(data)
+
+	return hash[:], nil
+}
+
+// watchConfig reloads the configuration whenever the config provider reports a change.
+func (sandwich *Sandwich) watchConfig(ctx context.Context, configWatcher ConfigWatcher) {
+	sandwich.Logger.Info("Watching config for changes")
+
+	configWatcher.Watch(ctx, func(ctx context.Context) {
+		_, err := sandwich.ReloadConfiguration(ctx, false)
+		if err == nil {
+			return
+		}
+
+		// The previous configuration is kept, as it is only replaced once the new one is valid.
+		sandwich.Logger.Error("Failed to reload configuration, keeping the last valid configuration", "error", err)
+
+		err = sandwich.Broadcast(SandwichEventConfigUpdate, &ConfigurationChangeReport{
+			DryRun:  false,
+			Changes: make([]ConfigurationChange, 0),
+			Error:   err.Error(),
+		})
+		if err != nil {
+			sandwich.Logger.Error("Failed to broadcast configuration reload", "error", err)
+		}
+	})
+}
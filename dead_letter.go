@@ -0,0 +1,417 @@
+package sandwich
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DeadLetterMaxLineSize is the largest dead letter that can be read back when replaying.
+var DeadLetterMaxLineSize = 64 * 1024 * 1024
+
+// DeadLetter is an event that a producer failed to publish.
+type DeadLetter struct {
+	FailedAt time.Time `json:"failed_at"`
+	// Producer is the name of the producer that failed to publish the event.
+	Producer string          `json:"producer"`
+	Error    string          `json:"error"`
+	Attempts int             `json:"attempts"`
+	Payload  ProducedPayload `json:"payload"`
+}
+
+// DeadLetterSpool appends dead letters to a newline delimited JSON file so they can be replayed later.
+type DeadLetterSpool struct {
+	identifier string
+	path       string
+	maxBytes   int64
+
+	mu   sync.Mutex
+	file *os.File
+
+	size      *atomic.Int64
+	count     *atomic.Int64
+	replaying *atomic.Bool
+}
+
+// OpenDeadLetterSpool opens the dead letter file at path, creating it if it does not exist. When maxBytes is more than 0,
+// dead letters are rejected once the file is that size. Identifier is the application identifier used in metrics.
+func OpenDeadLetterSpool(identifier, path string, maxBytes int64) (*DeadLetterSpool, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create dead letter directory: %w", err)
+	}
+
+	spool := &DeadLetterSpool{
+		identifier: identifier,
+		path:       path,
+		maxBytes:   maxBytes,
+
+		size:      &atomic.Int64{},
+		count:     &atomic.Int64{},
+		replaying: &atomic.Bool{},
+	}
+
+	if err := spool.open(); err != nil {
+		return nil, err
+	}
+
+	// Count the dead letters left from before, including a replay that did not finish.
+	for _, name := range []string{path, spool.replayPath()} {
+		size, count, err := countDeadLetters(name)
+		if err != nil {
+			spool.file.Close()
+
+			return nil, err
+		}
+
+		spool.size.Add(size)
+		spool.count.Add(count)
+	}
+
+	spool.updateMetrics()
+
+	return spool, nil
+}
+
+func (spool *DeadLetterSpool) replayPath() string {
+	return spool.path + ".replaying"
+}
+
+// open opens the dead letter file for appending. Must be called with mu held.
+func (spool *DeadLetterSpool) open() error {
+	file, err := os.OpenFile(spool.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open dead letter file: %w", err)
+	}
+
+	spool.file = file
+
+	return nil
+}
+
+func countDeadLetters(path string) (size, count int64, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, 0, nil
+		}
+
+		return 0, 0, fmt.Errorf("failed to open dead letter file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, DeadLetterMaxLineSize)
+
+	for scanner.Scan() {
+		size += int64(len(scanner.Bytes()) + 1)
+		count++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, 0, fmt.Errorf("failed to read dead letter file: %w", err)
+	}
+
+	return size, count, nil
+}
+
+func (spool *DeadLetterSpool) updateMetrics() {
+	UpdateDeadLetterSpool(spool.identifier, spool.size.Load(), spool.count.Load())
+}
+
+// Size returns the size of the dead letters in bytes and how many there are.
+func (spool *DeadLetterSpool) Size() (size, count int64) {
+	return spool.size.Load(), spool.count.Load()
+}
+
+// Append adds a dead letter to the end of the file.
+func (spool *DeadLetterSpool) Append(deadLetter *DeadLetter) error {
+	line, err := json.Marshal(deadLetter)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dead letter: %w", err)
+	}
+
+	return spool.appendLine(append(line, '\n'))
+}
+
+// appendLine writes a line to the end of the file.
+func (spool *DeadLetterSpool) appendLine(line []byte) error {
+	spool.mu.Lock()
+	defer spool.mu.Unlock()
+
+	if spool.file == nil {
+		return os.ErrClosed
+	}
+
+	if spool.maxBytes > 0 && spool.size.Load()+int64(len(line)) > spool.maxBytes {
+		return ErrDeadLetterSpoolFull
+	}
+
+	if _, err := spool.file.Write(line); err != nil {
+		return fmt.Errorf("failed to write dead letter: %w", err)
+	}
+
+	spool.size.Add(int64(len(line)))
+	spool.count.Add(1)
+	spool.updateMetrics()
+
+	return nil
+}
+
+// Replay calls fn with every dead letter, oldest first. Dead letters that fn returns an error for are
+// appended to the spool again. Dead letters that are appended while replaying are not replayed.
+func (spool *DeadLetterSpool) Replay(ctx context.Context, fn func(ctx context.Context, deadLetter *DeadLetter) error) (replayed, failed int64, err error) {
+	if !spool.replaying.CompareAndSwap(false, true) {
+		return 0, 0, ErrDeadLetterReplayInProgress
+	}
+	defer spool.replaying.Store(false)
+
+	replayPath := spool.replayPath()
+
+	// Move the dead letters aside so new dead letters can be appended while replaying. If a previous
+	// replay did not finish, its dead letters are replayed first and the rest are left for the next replay.
+	spool.mu.Lock()
+
+	if spool.file == nil {
+		spool.mu.Unlock()
+
+		return 0, 0, os.ErrClosed
+	}
+
+	if _, err := os.Stat(replayPath); errors.Is(err, os.ErrNotExist) {
+		if spool.file != nil {
+			spool.file.Close()
+		}
+
+		if err := os.Rename(spool.path, replayPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			spool.mu.Unlock()
+
+			return 0, 0, fmt.Errorf("failed to move dead letter file: %w", err)
+		}
+
+		if err := spool.open(); err != nil {
+			spool.file = nil
+			spool.mu.Unlock()
+
+			return 0, 0, err
+		}
+	}
+
+	spool.mu.Unlock()
+
+	file, err := os.Open(replayPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, 0, nil
+		}
+
+		return 0, 0, fmt.Errorf("failed to open dead letter file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, DeadLetterMaxLineSize)
+
+	// offset is where the dead letters that have not been replayed yet start in the replay file.
+	var offset int64
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		lineSize := int64(len(line) + 1)
+
+		// The dead letter is either replayed or appended again, so it is no longer counted here.
+		spool.size.Add(-lineSize)
+		spool.count.Add(-1)
+
+		var deadLetter DeadLetter
+
+		if err := json.Unmarshal(line, &deadLetter); err != nil {
+			failed++
+
+			// Keep dead letters that cannot be read, so they are not lost.
+			if appendErr := spool.appendLine(append(slices.Clone(line), '\n')); appendErr != nil {
+				return replayed, failed, spool.abortReplay(file, offset, lineSize, fmt.Errorf("failed to append dead letter: %w", appendErr))
+			}
+
+			offset += lineSize
+
+			continue
+		}
+
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		} else {
+			err = fn(ctx, &deadLetter)
+		}
+
+		if err == nil {
+			replayed++
+			offset += lineSize
+
+			continue
+		}
+
+		failed++
+
+		deadLetter.Error = err.Error()
+
+		if appendErr := spool.Append(&deadLetter); appendErr != nil {
+			return replayed, failed, spool.abortReplay(file, offset, lineSize, fmt.Errorf("failed to append dead letter: %w", appendErr))
+		}
+
+		offset += lineSize
+	}
+
+	if err := scanner.Err(); err != nil {
+		return replayed, failed, spool.abortReplay(file, offset, 0, fmt.Errorf("failed to read dead letter file: %w", err))
+	}
+
+	spool.updateMetrics()
+
+	file.Close()
+
+	if err := os.Remove(replayPath); err != nil {
+		return replayed, failed, fmt.Errorf("failed to remove dead letter file: %w", err)
+	}
+
+	return replayed, failed, ctx.Err()
+}
+
+// abortReplay stops a replay at offset, keeping the dead letters that have not been replayed in the replay
+// file so the next replay does not publish the ones before offset again. The dead letter being replayed
+// when the replay was aborted is counted again using lineSize.
+func (spool *DeadLetterSpool) abortReplay(file *os.File, offset, lineSize int64, err error) error {
+	spool.size.Add(lineSize)
+
+	if lineSize > 0 {
+		spool.count.Add(1)
+	}
+
+	spool.updateMetrics()
+
+	if _, seekErr := file.Seek(offset, io.SeekStart); seekErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to seek dead letter file: %w", seekErr))
+	}
+
+	replayPath := spool.replayPath()
+	tempPath := replayPath + ".tmp"
+
+	tempFile, createErr := os.OpenFile(tempPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if createErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to create dead letter file: %w", createErr))
+	}
+
+	_, copyErr := io.Copy(tempFile, file)
+	copyErr = errors.Join(copyErr, tempFile.Sync(), tempFile.Close())
+
+	if copyErr != nil {
+		os.Remove(tempPath)
+
+		return errors.Join(err, fmt.Errorf("failed to write dead letter file: %w", copyErr))
+	}
+
+	file.Close()
+
+	if renameErr := os.Rename(tempPath, replayPath); renameErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to move dead letter file: %w", renameErr))
+	}
+
+	return err
+}
+
+// Flush syncs the dead letter file to disk.
+func (spool *DeadLetterSpool) Flush(_ context.Context) error {
+	spool.mu.Lock()
+	defer spool.mu.Unlock()
+
+	if spool.file == nil {
+		return nil
+	}
+
+	return spool.file.Sync()
+}
+
+// Close syncs and closes the dead letter file.
+func (spool *DeadLetterSpool) Close() error {
+	if spool == nil {
+		return nil
+	}
+
+	spool.mu.Lock()
+	defer spool.mu.Unlock()
+
+	if spool.file == nil {
+		return nil
+	}
+
+	err := errors.Join(spool.file.Sync(), spool.file.Close())
+	spool.file = nil
+
+	return err
+}
+
+// retryingProducer returns the retrying producer with a name.
+func (application *Application) retryingProducer(name string) *RetryingProducer {
+	producer := application.producer
+
+	if spoolProducer, ok := producer.(*SpoolProducer); ok {
+		producer = spoolProducer.producer
+	}
+
+	if routedProducer, ok := producer.(*RoutedProducer); ok {
+		producer = routedProducer.producers[name]
+	}
+
+	if retryingProducer, ok := producer.(*RetryingProducer); ok && retryingProducer.name == name {
+		return retryingProducer
+	}
+
+	return nil
+}
+
+// ReplayDeadLetters publishes the events in the dead letter file again, using the producer that failed to publish them.
+// Events that fail again are kept in the dead letter file. Returns how many events were replayed and how many failed.
+func (application *Application) ReplayDeadLetters(ctx context.Context) (replayed, failed int64, err error) {
+	deadLetters := application.deadLetters
+	if deadLetters == nil {
+		return 0, 0, ErrDeadLettersDisabled
+	}
+
+	application.Logger.Info("Replaying dead letters")
+
+	replayed, failed, err = deadLetters.Replay(ctx, func(ctx context.Context, deadLetter *DeadLetter) error {
+		producer := application.retryingProducer(deadLetter.Producer)
+		if producer == nil {
+			return fmt.Errorf("%w: %q", ErrProducerNotFound, deadLetter.Producer)
+		}
+
+		// The shard that received the event is passed when it still exists.
+		shard, _ := application.Shards.Load(deadLetter.Payload.Metadata.Shard[1])
+
+		_, err := producer.publish(ctx, shard, &deadLetter.Payload)
+
+		return err
+	})
+
+	application.Logger.Info("Replayed dead letters", "replayed", replayed, "failed", failed, "error", err)
+
+	return replayed, failed, err
+}
+
+// ReplayDeadLetters replays the dead letters of an application.
+func (sandwich *Sandwich) ReplayDeadLetters(ctx context.Context, applicationIdentifier string) (replayed, failed int64, err error) {
+	application, ok := sandwich.Applications.Load(applicationIdentifier)
+	if !ok {
+		return 0, 0, ErrApplicationNotFound
+	}
+
+	return application.ReplayDeadLetters(ctx)
+}
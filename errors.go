@@ -3,9 +3,15 @@ package sandwich
 import "errors"
 
 var (
+	ErrConfigurationMissingField       = errors.New("missing required field")
+	ErrConfigurationMissingEnvironment = errors.New("environment variable is not set")
+	ErrConfigVersionNotFound           = errors.New("config version not found")
+	ErrConfigVersioningUnsupported     = errors.New("config provider does not support versioning")
+
 	ErrApplicationMissingIdentifier = errors.New("application missing identifier")
 	ErrApplicationMissingBotToken   = errors.New("application missing bot token")
 	ErrApplicationIdentifierExists  = errors.New("application identifier already exists")
+	ErrApplicationInvalidShardIDs   = errors.New("application shard ids are invalid")
 
 	ErrApplicationInitializeFailed = errors.New("application initialize failed")
 	ErrApplicationMissingShards    = errors.New("application missing shards")
@@ -14,6 +20,12 @@ var (
 	ErrApplicationAlreadyRunning = errors.New("application already running")
 	ErrApplicationNotRunning     = errors.New("application not running")
 
+	ErrApplicationTokenInvalid  = errors.New("application bot token is invalid")
+	ErrApplicationTokenMismatch = errors.New("application bot token belongs to a different user")
+
+	ErrGatewayBotInvalidResponse = errors.New("gateway bot response is invalid")
+	ErrGatewayBotRateLimited     = errors.New("gateway bot request is rate limited")
+
 	ErrShardConnectFailed            = errors.New("shard connect failed")
 	ErrShardInvalidHeartbeatInterval = errors.New("shard invalid heartbeat interval")
 	ErrShardStopping                 = errors.New("shard stopping")
@@ -24,4 +36,23 @@ var (
 	ErrGuildNotFound = errors.New("guild not found")
 	ErrShardNotFound = errors.New("shard not found")
 	ErrUserNotFound  = errors.New("user not found")
+
+	ErrProducerTypeUnknown = errors.New("unknown producer type")
+	ErrProducerNotFound    = errors.New("producer not found")
+	// ErrProducerPermanent can be wrapped by producers for errors that should not be retried.
+	ErrProducerPermanent = errors.New("permanent producer error")
+	ErrProducerClosed    = errors.New("producer is closed")
+	ErrSocketPathInUse   = errors.New("socket path exists and is not a socket")
+
+	ErrDeadLettersDisabled        = errors.New("application has no dead letter file")
+	ErrDeadLetterSpoolFull        = errors.New("dead letter file is full")
+	ErrDeadLetterReplayInProgress = errors.New("dead letters are already being replayed")
+
+	ErrSpoolFull = errors.New("spool is full")
+
+	ErrListenerOverflow = errors.New("listener disconnected due to buffer overflow")
+
+	ErrSecretNotFound = errors.New("secret not found")
+	// ErrSecretNotReference is returned when a raw secret would be saved to the configuration.
+	ErrSecretNotReference = errors.New("secret must be a reference such as env:NAME or file:PATH to be saved")
 )
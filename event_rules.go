@@ -0,0 +1,105 @@
+package sandwich
+
+import (
+	"fmt"
+
+	"github.com/WelcomerTeam/Discord/discord"
+)
+
+// EventRuleAction is what happens to an event that matches an event rule.
+type EventRuleAction string
+
+const (
+	// EventRuleActionDrop does not produce the event.
+	EventRuleActionDrop EventRuleAction = "drop"
+	// EventRuleActionProduce produces the event, and no later rules are checked.
+	EventRuleActionProduce EventRuleAction = "produce"
+)
+
+// EventRuleConfiguration is a rule that decides if an event is produced. Rules are checked in order
+// and the first rule whose expression is true decides. Events that match no rule are produced.
+//
+// For example, to drop messages from bots:
+//
+//	{"name": "ignore-bots", "action": "drop", "when": "type == \"MESSAGE_CREATE\" && data.author.bot"}
+type EventRuleConfiguration struct {
+	Name   string          `json:"name"`
+	Action EventRuleAction `json:"action"`
+	// When is the expression that is evaluated against each event. See event_rules_expression.go for the syntax.
+	When string `json:"when"`
+}
+
+// EventRules are compiled event rules.
+type EventRules struct {
+	rules []*eventRule
+}
+
+type eventRule struct {
+	name       string
+	action     EventRuleAction
+	expression eventRuleNode
+}
+
+// CompileEventRules compiles event rules so they can be evaluated. Errors are ConfigurationErrors
+// with the field of the rule that is invalid.
+func CompileEventRules(rules []EventRuleConfiguration) (*EventRules, error) {
+	compiled, errs := compileEventRules("event_rules", rules)
+	if err := errs.orNil(); err != nil {
+		return nil, err
+	}
+
+	return compiled, nil
+}
+
+// compileEventRules compiles event rules. Field paths are prefixed with path.
+func compileEventRules(path string, rules []EventRuleConfiguration) (*EventRules, ConfigurationErrors) {
+	var errs ConfigurationErrors
+
+	compiled := &EventRules{
+		rules: make([]*eventRule, 0, len(rules)),
+	}
+
+	for index, rule := range rules {
+		rulePath := fmt.Sprintf("%s[%d]", path, index)
+
+		if rule.Action != EventRuleActionDrop && rule.Action != EventRuleActionProduce {
+			errs.addf(rulePath+".action", "unknown action %q, expected drop or produce", rule.Action)
+		}
+
+		expression, err := compileEventRuleExpression(rule.When)
+		if err != nil {
+			errs.add(rulePath+".when", err)
+
+			continue
+		}
+
+		compiled.rules = append(compiled.rules, &eventRule{
+			name:       rule.Name,
+			action:     rule.Action,
+			expression: expression,
+		})
+	}
+
+	return compiled, errs
+}
+
+// Evaluate returns the action for an event, and the name of the rule that matched.
+// Events that match no rule are produced.
+func (rules *EventRules) Evaluate(event *discord.GatewayPayload, metadata *ProducedMetadata) (action EventRuleAction, rule string) {
+	if rules == nil || len(rules.rules) == 0 {
+		return EventRuleActionProduce, ""
+	}
+
+	ctx := &eventRuleContext{
+		event:    event,
+		metadata: metadata,
+	}
+
+	for _, rule := range rules.rules {
+		if eventRuleTruthy(rule.expression.eval(ctx)) {
+			return rule.action, rule.name
+		}
+	}
+
+	return EventRuleActionProduce, ""
+}
@@ -0,0 +1,804 @@
+package sandwich
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/WelcomerTeam/Discord/discord"
+)
+
+// Event rule expressions are a small language evaluated against an event.
+//
+// Fields:
+//   - type: the event type, such as "MESSAGE_CREATE"
+//   - guild_id: the guild the event belongs to, or null
+//   - data.<path>: a field of the event payload, such as data.author.bot or data.member.roles
+//   - metadata.<field>: a field of the produced metadata. One of identifier, application,
+//     application_id, shard_id or shard_count.
+//
+// Literals are strings ("text"), numbers, true, false, null and lists ([1, 2, 3]).
+//
+// Operators, from lowest to highest precedence, are ||, &&, ! and the comparisons ==, !=, <, <=, >, >= and in.
+// Strings are compared with numbers as numbers, so snowflakes can be written either way.
+// "x in [...]" is true if x is in the list and "x in data.field" is true if the field is a list that contains x.
+
+// eventRuleNode is a compiled expression.
+type eventRuleNode interface {
+	eval(ctx *eventRuleContext) any
+}
+
+// eventRuleContext is the event an expression is evaluated against. Fields are decoded when first used.
+type eventRuleContext struct {
+	event    *discord.GatewayPayload
+	metadata *ProducedMetadata
+
+	guildIDLoaded bool
+	guildID       any
+
+	dataLoaded bool
+	data       any
+}
+
+func (ctx *eventRuleContext) getGuildID() any {
+	if !ctx.guildIDLoaded {
+		ctx.guildIDLoaded = true
+
+		if guildID, ok := eventGuildID(ctx.event); ok {
+			ctx.guildID = int64(guildID)
+		}
+	}
+
+	return ctx.guildID
+}
+
+func (ctx *eventRuleContext) getData() any {
+	if !ctx.dataLoaded {
+		ctx.dataLoaded = true
+
+		decoder := json.NewDecoder(bytes.NewReader(ctx.event.Data))
+		decoder.UseNumber()
+
+		if err := decoder.Decode(&ctx.data); err != nil {
+			ctx.data = nil
+		}
+	}
+
+	return ctx.data
+}
+
+type literalNode struct {
+	value any
+}
+
+func (node *literalNode) eval(_ *eventRuleContext) any {
+	return node.value
+}
+
+type typeNode struct{}
+
+func (node *typeNode) eval(ctx *eventRuleContext) any {
+	return ctx.event.Type
+}
+
+type guildIDNode struct{}
+
+func (node *guildIDNode) eval(ctx *eventRuleContext) any {
+	return ctx.getGuildID()
+}
+
+type metadataNode struct {
+	field string
+}
+
+func (node *metadataNode) eval(ctx *eventRuleContext) any {
+	if ctx.metadata == nil {
+		return nil
+	}
+
+	switch node.field {
+	case "identifier":
+		return ctx.metadata.Identifier
+	case "application":
+		return ctx.metadata.Application
+	case "application_id":
+		return int64(ctx.metadata.ApplicationID)
+	case "shard_id":
+		return int64(ctx.metadata.Shard[1])
+	case "shard_count":
+		return int64(ctx.metadata.Shard[2])
+	}
+
+	return nil
+}
+
+var eventRuleMetadataFields = []string{"identifier", "application", "application_id", "shard_id", "shard_count"}
+
+type dataNode struct {
+	path []string
+}
+
+func (node *dataNode) eval(ctx *eventRuleContext) any {
+	value := ctx.getData()
+
+	for _, key := range node.path {
+		switch typed := value.(type) {
+		case map[string]any:
+			value = typed[key]
+		case []any:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(typed) {
+				return nil
+			}
+
+			value = typed[index]
+		default:
+			return nil
+		}
+	}
+
+	return normalizeEventRuleValue(value)
+}
+
+type notNode struct {
+	operand eventRuleNode
+}
+
+func (node *notNode) eval(ctx *eventRuleContext) any {
+	return !eventRuleTruthy(node.operand.eval(ctx))
+}
+
+type andNode struct {
+	left, right eventRuleNode
+}
+
+func (node *andNode) eval(ctx *eventRuleContext) any {
+	return eventRuleTruthy(node.left.eval(ctx)) && eventRuleTruthy(node.right.eval(ctx))
+}
+
+type orNode struct {
+	left, right eventRuleNode
+}
+
+func (node *orNode) eval(ctx *eventRuleContext) any {
+	return eventRuleTruthy(node.left.eval(ctx)) || eventRuleTruthy(node.right.eval(ctx))
+}
+
+type compareNode struct {
+	operator    string
+	left, right eventRuleNode
+}
+
+func (node *compareNode) eval(ctx *eventRuleContext) any {
+	left := node.left.eval(ctx)
+	right := node.right.eval(ctx)
+
+	switch node.operator {
+	case "==":
+		return eventRuleEqual(left, right)
+	case "!=":
+		return !eventRuleEqual(left, right)
+	}
+
+	comparison, ok := eventRuleCompare(left, right)
+	if !ok {
+		return false
+	}
+
+	switch node.operator {
+	case "<":
+		return comparison < 0
+	case "<=":
+		return comparison <= 0
+	case ">":
+		return comparison > 0
+	case ">=":
+		return comparison >= 0
+	}
+
+	return false
+}
+
+type listNode struct {
+	items []eventRuleNode
+}
+
+func (node *listNode) eval(ctx *eventRuleContext) any {
+	list := make([]any, 0, len(node.items))
+	for _, item := range node.items {
+		list = append(list, item.eval(ctx))
+	}
+
+	return list
+}
+
+type inNode struct {
+	left, right eventRuleNode
+}
+
+func (node *inNode) eval(ctx *eventRuleContext) any {
+	left := node.left.eval(ctx)
+
+	list, ok := node.right.eval(ctx).([]any)
+	if !ok {
+		return false
+	}
+
+	for _, item := range list {
+		if eventRuleEqual(left, normalizeEventRuleValue(item)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inSetNode is an in operator with a literal list, which is compiled to a set.
+type inSetNode struct {
+	left eventRuleNode
+	set  map[string]struct{}
+}
+
+func (node *inSetNode) eval(ctx *eventRuleContext) any {
+	key, ok := eventRuleSetKey(node.left.eval(ctx))
+	if !ok {
+		return false
+	}
+
+	_, ok = node.set[key]
+
+	return ok
+}
+
+// normalizeEventRuleValue converts decoded JSON numbers to int64 or float64.
+func normalizeEventRuleValue(value any) any {
+	number, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+
+	if integer, err := number.Int64(); err == nil {
+		return integer
+	}
+
+	if float, err := number.Float64(); err == nil {
+		return float
+	}
+
+	return number.String()
+}
+
+func eventRuleTruthy(value any) bool {
+	switch typed := value.(type) {
+	case bool:
+		return typed
+	case string:
+		return typed != ""
+	case int64:
+		return typed != 0
+	case float64:
+		return typed != 0
+	case []any:
+		return len(typed) > 0
+	case map[string]any:
+		return len(typed) > 0
+	}
+
+	return false
+}
+
+// eventRuleNumber returns a value as a number. Strings are parsed, so snowflakes can be compared with numbers.
+func eventRuleNumber(value any) (integer int64, float float64, isInteger, ok bool) {
+	switch typed := value.(type) {
+	case int64:
+		return typed, float64(typed), true, true
+	case float64:
+		return 0, typed, false, true
+	case string:
+		if !looksNumeric(typed) {
+			return 0, 0, false, false
+		}
+
+		if integer, err := strconv.ParseInt(typed, 10, 64); err == nil {
+			return integer, float64(integer), true, true
+		}
+
+		if float, err := strconv.ParseFloat(typed, 64); err == nil {
+			return 0, float, false, true
+		}
+	}
+
+	return 0, 0, false, false
+}
+
+// looksNumeric returns true if a string starts like a number, so most strings are not parsed.
+func looksNumeric(value string) bool {
+	return value != "" && (value[0] == '-' || (value[0] >= '0' && value[0] <= '9'))
+}
+
+func eventRuleEqual(left, right any) bool {
+	leftString, leftIsString := left.(string)
+	rightString, rightIsString := right.(string)
+
+	if leftIsString && rightIsString {
+		return leftString == rightString
+	}
+
+	if comparison, ok := eventRuleCompare(left, right); ok {
+		return comparison == 0
+	}
+
+	switch typed := left.(type) {
+	case nil:
+		return right == nil
+	case bool:
+		rightBool, ok := right.(bool)
+
+		return ok && typed == rightBool
+	}
+
+	return false
+}
+
+// eventRuleCompare compares two numbers or two strings.
+func eventRuleCompare(left, right any) (int, bool) {
+	leftString, leftIsString := left.(string)
+	rightString, rightIsString := right.(string)
+
+	if leftIsString && rightIsString {
+		return strings.Compare(leftString, rightString), true
+	}
+
+	leftInteger, leftFloat, leftIsInteger, ok := eventRuleNumber(left)
+	if !ok {
+		return 0, false
+	}
+
+	rightInteger, rightFloat, rightIsInteger, ok := eventRuleNumber(right)
+	if !ok {
+		return 0, false
+	}
+
+	if leftIsInteger && rightIsInteger {
+		switch {
+		case leftInteger < rightInteger:
+			return -1, true
+		case leftInteger > rightInteger:
+			return 1, true
+		}
+
+		return 0, true
+	}
+
+	switch {
+	case leftFloat < rightFloat:
+		return -1, true
+	case leftFloat > rightFloat:
+		return 1, true
+	}
+
+	return 0, true
+}
+
+// eventRuleSetKey returns the key of a value in a set. Numbers and strings of numbers have the same key.
+func eventRuleSetKey(value any) (string, bool) {
+	switch typed := value.(type) {
+	case string:
+		if !looksNumeric(typed) {
+			return "s:" + typed, true
+		}
+
+		if integer, err := strconv.ParseInt(typed, 10, 64); err == nil {
+			return strconv.FormatInt(integer, 10), true
+		}
+
+		return "s:" + typed, true
+	case int64:
+		return strconv.FormatInt(typed, 10), true
+	case float64:
+		return strconv.FormatFloat(typed, 'g', -1, 64), true
+	case bool:
+		return strconv.FormatBool(typed), true
+	case nil:
+		return "null", true
+	}
+
+	return "", false
+}
+
+// EventRuleSyntaxError is an error in an event rule expression.
+type EventRuleSyntaxError struct {
+	Offset  int
+	Message string
+}
+
+func (e *EventRuleSyntaxError) Error() string {
+	return fmt.Sprintf("at offset %d: %s", e.Offset, e.Message)
+}
+
+type eventRuleTokenKind int
+
+const (
+	eventRuleTokenEOF eventRuleTokenKind = iota
+	eventRuleTokenIdentifier
+	eventRuleTokenString
+	eventRuleTokenNumber
+	eventRuleTokenOperator
+)
+
+type eventRuleToken struct {
+	kind   eventRuleTokenKind
+	value  string
+	offset int
+}
+
+var eventRuleOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", ","}
+
+func tokenizeEventRule(expression string) ([]eventRuleToken, error) {
+	var tokens []eventRuleToken
+
+	offset := 0
+
+	for offset < len(expression) {
+		character := rune(expression[offset])
+
+		switch {
+		case unicode.IsSpace(character):
+			offset++
+		case character == '"':
+			end := offset + 1
+			for end < len(expression) && expression[end] != '"' {
+				if expression[end] == '\\' {
+					end++
+				}
+
+				end++
+			}
+
+			if end >= len(expression) {
+				return nil, &EventRuleSyntaxError{offset, "unterminated string"}
+			}
+
+			value, err := strconv.Unquote(expression[offset : end+1])
+			if err != nil {
+				return nil, &EventRuleSyntaxError{offset, "invalid string " + expression[offset:end+1]}
+			}
+
+			tokens = append(tokens, eventRuleToken{eventRuleTokenString, value, offset})
+			offset = end + 1
+		case unicode.IsDigit(character) || (character == '-' && offset+1 < len(expression) && unicode.IsDigit(rune(expression[offset+1]))):
+			end := offset + 1
+			for end < len(expression) && (unicode.IsDigit(rune(expression[end])) || expression[end] == '.') {
+				end++
+			}
+
+			tokens = append(tokens, eventRuleToken{eventRuleTokenNumber, expression[offset:end], offset})
+			offset = end
+		case unicode.IsLetter(character) || character == '_':
+			end := offset + 1
+			for end < len(expression) && isEventRuleIdentifierCharacter(rune(expression[end])) {
+				end++
+			}
+
+			tokens = append(tokens, eventRuleToken{eventRuleTokenIdentifier, expression[offset:end], offset})
+			offset = end
+		default:
+			matched := false
+
+			for _, operator := range eventRuleOperators {
+				if strings.HasPrefix(expression[offset:], operator) {
+					tokens = append(tokens, eventRuleToken{eventRuleTokenOperator, operator, offset})
+					offset += len(operator)
+					matched = true
+
+					break
+				}
+			}
+
+			if !matched {
+				return nil, &EventRuleSyntaxError{offset, fmt.Sprintf("unexpected character %q", character)}
+			}
+		}
+	}
+
+	return append(tokens, eventRuleToken{eventRuleTokenEOF, "", len(expression)}), nil
+}
+
+func isEventRuleIdentifierCharacter(character rune) bool {
+	return unicode.IsLetter(character) || unicode.IsDigit(character) || character == '_' || character == '.'
+}
+
+type eventRuleParser struct {
+	tokens   []eventRuleToken
+	position int
+}
+
+// compileEventRuleExpression parses an expression into a node that can be evaluated.
+func compileEventRuleExpression(expression string) (eventRuleNode, error) {
+	tokens, err := tokenizeEventRule(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &eventRuleParser{tokens: tokens}
+
+	node, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := parser.peek(); token.kind != eventRuleTokenEOF {
+		return nil, &EventRuleSyntaxError{token.offset, fmt.Sprintf("unexpected %q", token.value)}
+	}
+
+	return node, nil
+}
+
+func (parser *eventRuleParser) peek() eventRuleToken {
+	return parser.tokens[parser.position]
+}
+
+func (parser *eventRuleParser) next() eventRuleToken {
+	token := parser.tokens[parser.position]
+
+	if token.kind != eventRuleTokenEOF {
+		parser.position++
+	}
+
+	return token
+}
+
+func (parser *eventRuleParser) isOperator(operator string) bool {
+	token := parser.peek()
+
+	return token.kind == eventRuleTokenOperator && token.value == operator
+}
+
+func (parser *eventRuleParser) expect(operator string) error {
+	token := parser.next()
+	if token.kind != eventRuleTokenOperator || token.value != operator {
+		return &EventRuleSyntaxError{token.offset, fmt.Sprintf("expected %q, got %s", operator, describeEventRuleToken(token))}
+	}
+
+	return nil
+}
+
+func (parser *eventRuleParser) parseOr() (eventRuleNode, error) {
+	left, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for parser.isOperator("||") {
+		parser.next()
+
+		right, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &orNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (parser *eventRuleParser) parseAnd() (eventRuleNode, error) {
+	left, err := parser.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for parser.isOperator("&&") {
+		parser.next()
+
+		right, err := parser.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &andNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (parser *eventRuleParser) parseNot() (eventRuleNode, error) {
+	if parser.isOperator("!") {
+		parser.next()
+
+		operand, err := parser.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		return &notNode{operand}, nil
+	}
+
+	return parser.parseComparison()
+}
+
+func (parser *eventRuleParser) parseComparison() (eventRuleNode, error) {
+	left, err := parser.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	token := parser.peek()
+
+	switch {
+	case token.kind == eventRuleTokenOperator && (token.value == "==" || token.value == "!=" ||
+		token.value == "<" || token.value == "<=" || token.value == ">" || token.value == ">="):
+		parser.next()
+
+		right, err := parser.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &compareNode{token.value, left, right}, nil
+	case token.kind == eventRuleTokenIdentifier && token.value == "in":
+		parser.next()
+
+		right, err := parser.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+
+		return newInNode(left, right), nil
+	}
+
+	return left, nil
+}
+
+// newInNode compiles an in operator. Lists of literals become sets.
+func newInNode(left, right eventRuleNode) eventRuleNode {
+	list, ok := right.(*listNode)
+	if !ok {
+		return &inNode{left, right}
+	}
+
+	set := make(map[string]struct{}, len(list.items))
+
+	for _, item := range list.items {
+		literal, ok := item.(*literalNode)
+		if !ok {
+			return &inNode{left, right}
+		}
+
+		key, ok := eventRuleSetKey(literal.value)
+		if !ok {
+			return &inNode{left, right}
+		}
+
+		set[key] = struct{}{}
+	}
+
+	return &inSetNode{left, set}
+}
+
+func (parser *eventRuleParser) parsePrimary() (eventRuleNode, error) {
+	token := parser.next()
+
+	switch token.kind {
+	case eventRuleTokenString:
+		return &literalNode{token.value}, nil
+	case eventRuleTokenNumber:
+		if integer, err := strconv.ParseInt(token.value, 10, 64); err == nil {
+			return &literalNode{integer}, nil
+		}
+
+		float, err := strconv.ParseFloat(token.value, 64)
+		if err != nil {
+			return nil, &EventRuleSyntaxError{token.offset, "invalid number " + token.value}
+		}
+
+		return &literalNode{float}, nil
+	case eventRuleTokenIdentifier:
+		return parseEventRuleIdentifier(token)
+	case eventRuleTokenOperator:
+		switch token.value {
+		case "(":
+			node, err := parser.parseOr()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := parser.expect(")"); err != nil {
+				return nil, err
+			}
+
+			return node, nil
+		case "[":
+			return parser.parseList()
+		}
+	case eventRuleTokenEOF:
+	}
+
+	return nil, &EventRuleSyntaxError{token.offset, "expected a value, got " + describeEventRuleToken(token)}
+}
+
+func (parser *eventRuleParser) parseList() (eventRuleNode, error) {
+	list := &listNode{}
+
+	if parser.isOperator("]") {
+		parser.next()
+
+		return list, nil
+	}
+
+	for {
+		item, err := parser.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+
+		list.items = append(list.items, item)
+
+		if parser.isOperator(",") {
+			parser.next()
+
+			continue
+		}
+
+		if err := parser.expect("]"); err != nil {
+			return nil, err
+		}
+
+		return list, nil
+	}
+}
+
+func parseEventRuleIdentifier(token eventRuleToken) (eventRuleNode, error) {
+	switch token.value {
+	case "true":
+		return &literalNode{true}, nil
+	case "false":
+		return &literalNode{false}, nil
+	case "null":
+		return &literalNode{nil}, nil
+	case "type":
+		return &typeNode{}, nil
+	case "guild_id":
+		return &guildIDNode{}, nil
+	}
+
+	root, path, _ := strings.Cut(token.value, ".")
+
+	switch root {
+	case "data":
+		if path == "" {
+			return &dataNode{}, nil
+		}
+
+		segments := strings.Split(path, ".")
+		if slices.Contains(segments, "") {
+			return nil, &EventRuleSyntaxError{token.offset, "invalid field " + token.value}
+		}
+
+		return &dataNode{segments}, nil
+	case "metadata":
+		for _, field := range eventRuleMetadataFields {
+			if path == field {
+				return &metadataNode{field}, nil
+			}
+		}
+
+		return nil, &EventRuleSyntaxError{token.offset, fmt.Sprintf("unknown metadata field %q, expected one of %s",
+			path, strings.Join(eventRuleMetadataFields, ", "))}
+	}
+
+	return nil, &EventRuleSyntaxError{token.offset, fmt.Sprintf("unknown field %q, expected type, guild_id, data.<path> or metadata.<field>", token.value)}
+}
+
+func describeEventRuleToken(token eventRuleToken) string {
+	if token.kind == eventRuleTokenEOF {
+		return "end of expression"
+	}
+
+	return strconv.Quote(token.value)
+}
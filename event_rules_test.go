@@ -0,0 +1,131 @@
+package sandwich_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/WelcomerTeam/Discord/discord"
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+var testMessageCreate = &discord.GatewayPayload{
+	Op:   discord.GatewayOpDispatch,
+	Type: discord.DiscordEventMessageCreate,
+	Data: json.RawMessage(`{"id":"1300000000000000001","guild_id":"1300000000000000002","channel_id":"1300000000000000003",` +
+		`"content":"hello","author":{"id":"1300000000000000004","username":"bot","bot":true},` +
+		`"member":{"roles":["1300000000000000005","1300000000000000006"]}}`),
+}
+
+var testMetadata = &sandwich.ProducedMetadata{
+	Identifier:    "welcomer",
+	Application:   "welcomer",
+	ApplicationID: 1300000000000000000,
+	Shard:         [3]int32{0, 3, 8},
+}
+
+func TestEventRulesEvaluate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		when    string
+		matches bool
+	}{
+		{`type == "MESSAGE_CREATE"`, true},
+		{`type == "MESSAGE_CREATE" && data.author.bot`, true},
+		{`type == "MESSAGE_CREATE" && !data.author.bot`, false},
+		{`guild_id == 1300000000000000002`, true},
+		{`guild_id == "1300000000000000002"`, true},
+		{`guild_id == 1300000000000000003`, false},
+		{`guild_id in [1300000000000000002, 1300000000000000009]`, true},
+		{`!(guild_id in [1300000000000000009])`, true},
+		{`1300000000000000006 in data.member.roles`, true},
+		{`data.member.roles.0 == 1300000000000000005`, true},
+		{`data.missing.field == null`, true},
+		{`metadata.shard_id == 3 && metadata.shard_count > 4`, true},
+		{`metadata.application == "other" || type in ["PRESENCE_UPDATE", "TYPING_START"]`, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.when, func(t *testing.T) {
+			t.Parallel()
+
+			rules, err := sandwich.CompileEventRules([]sandwich.EventRuleConfiguration{
+				{Name: "rule", Action: sandwich.EventRuleActionDrop, When: test.when},
+			})
+			assert.NoError(t, err)
+
+			action, rule := rules.Evaluate(testMessageCreate, testMetadata)
+			if test.matches {
+				assert.Equal(t, sandwich.EventRuleActionDrop, action)
+				assert.Equal(t, "rule", rule)
+			} else {
+				assert.Equal(t, sandwich.EventRuleActionProduce, action)
+				assert.Empty(t, rule)
+			}
+		})
+	}
+}
+
+func TestCompileEventRulesErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := sandwich.CompileEventRules([]sandwich.EventRuleConfiguration{
+		{Action: sandwich.EventRuleActionDrop, When: `type == "MESSAGE_CREATE"`},
+		{Action: "ignore", When: `type == "TYPING_START"`},
+		{Action: sandwich.EventRuleActionDrop, When: `author.bot`},
+		{Action: sandwich.EventRuleActionDrop, When: `type == `},
+		{Action: sandwich.EventRuleActionDrop, When: `(type == "MESSAGE_CREATE"`},
+	})
+
+	var configurationErrors sandwich.ConfigurationErrors
+
+	assert.ErrorAs(t, err, &configurationErrors)
+	assert.Len(t, configurationErrors, 4)
+	assert.Equal(t, "event_rules[1].action", configurationErrors[0].Field)
+	assert.Equal(t, "event_rules[2].when", configurationErrors[1].Field)
+	assert.Contains(t, configurationErrors[1].Error(), `unknown field "author.bot"`)
+	assert.Equal(t, "event_rules[3].when", configurationErrors[2].Field)
+	assert.Contains(t, configurationErrors[2].Error(), "expected a value, got end of expression")
+	assert.Equal(t, "event_rules[4].when", configurationErrors[3].Field)
+	assert.Contains(t, configurationErrors[3].Error(), `expected ")"`)
+}
+
+func BenchmarkEventRulesEvaluate(b *testing.B) {
+	benchmarks := []struct {
+		name  string
+		rules []sandwich.EventRuleConfiguration
+	}{
+		{
+			name: "type",
+			rules: []sandwich.EventRuleConfiguration{
+				{Action: sandwich.EventRuleActionDrop, When: `type in ["PRESENCE_UPDATE", "TYPING_START"]`},
+			},
+		},
+		{
+			name: "guild",
+			rules: []sandwich.EventRuleConfiguration{
+				{Action: sandwich.EventRuleActionDrop, When: `!(guild_id in [1300000000000000002, 1300000000000000009])`},
+			},
+		},
+		{
+			name: "payload",
+			rules: []sandwich.EventRuleConfiguration{
+				{Action: sandwich.EventRuleActionDrop, When: `type == "MESSAGE_CREATE" && data.author.bot`},
+			},
+		},
+	}
+
+	for _, benchmark := range benchmarks {
+		b.Run(benchmark.name, func(b *testing.B) {
+			rules, err := sandwich.CompileEventRules(benchmark.rules)
+			assert.NoError(b, err)
+
+			b.ReportAllocs()
+
+			for b.Loop() {
+				rules.Evaluate(testMessageCreate, testMetadata)
+			}
+		})
+	}
+}
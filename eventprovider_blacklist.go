@@ -13,7 +13,9 @@ import (
 )
 
 // EventProviderWithBlacklist is an event provider that will not handle events that are in the blacklist
-// and not publish events that are in the produce blacklist.
+// and not publish events that are in the produce blacklist or are filtered by the guild allow and deny lists.
+// Middlewares registered with WithEventMiddleware are called before and after dispatch and before publish.
+// Payload projections are applied to the data of events before they are published.
 
 type EventProviderWithBlacklist struct {
 	dispatchProvider EventDispatchProvider
@@ -40,6 +42,30 @@ func (p *EventProviderWithBlacklist) Dispatch(ctx context.Context, shard *Shard,
 		return nil
 	}
 
+	guildFilterReason, skipState := shard.Application.filterGuildEvent(event)
+	if guildFilterReason != GuildFilterReasonNone && skipState {
+		shard.trackFilteredGuild(event)
+
+		RecordFilteredEvent(shard.Application.Identifier, event.Type, string(guildFilterReason))
+
+		return nil
+	}
+
+	if trace == nil {
+		trace = &Trace{}
+	}
+
+	ok, err := shard.Sandwich.beforeDispatch(ctx, shard, event, trace)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		RecordFilteredEvent(shard.Application.Identifier, event.Type, "middleware")
+
+		return nil
+	}
+
 	result, continuable, err := p.dispatchProvider.Dispatch(ctx, shard, event, trace)
 	if err != nil {
 		if !errors.Is(err, ErrNoDispatchHandler) {
@@ -51,28 +77,72 @@ func (p *EventProviderWithBlacklist) Dispatch(ctx context.Context, shard *Shard,
 		return nil
 	}
 
+	ok, err = shard.Sandwich.afterDispatch(ctx, shard, event, &result, trace)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		RecordFilteredEvent(shard.Application.Identifier, event.Type, "middleware")
+
+		return nil
+	}
+
 	produceBlacklist := shard.Application.Configuration.Load().ProduceBlacklist
 
 	if slices.Contains(produceBlacklist, event.Type) {
 		return nil
 	}
 
-	if trace == nil {
-		trace = &Trace{}
+	if guildFilterReason != GuildFilterReasonNone {
+		RecordFilteredEvent(shard.Application.Identifier, event.Type, string(guildFilterReason))
+
+		return nil
+	}
+
+	metadata := shard.Metadata.Load()
+	compiled := shard.Application.compiledConfiguration()
+
+	if action, _ := compiled.eventRules.Evaluate(event, metadata); action == EventRuleActionDrop {
+		RecordFilteredEvent(shard.Application.Identifier, event.Type, "event_rule")
+
+		return nil
 	}
 
 	packet := p.producedPayloadPool.Get().(*ProducedPayload)
 
 	packet.GatewayPayload = *event
-	packet.Metadata = *shard.Metadata.Load()
+	packet.Metadata = *metadata
 	packet.Trace = *trace
 
+	if projection, ok := compiled.payloadProjections[event.Type]; ok {
+		data, err := projection.Apply(packet.Data)
+		if err != nil {
+			shard.Logger.Debug("Failed to apply payload projection", "type", event.Type, "error", err)
+		} else {
+			packet.Data = data
+		}
+	}
+
 	if result.Extra != nil {
 		packet.Extra = *result.Extra
 	} else {
 		packet.Extra = nil
 	}
 
+	ok, err = shard.Sandwich.beforePublish(ctx, shard, packet)
+	if err != nil || !ok {
+		p.producedPayloadPool.Put(packet)
+
+		if err != nil {
+			return err
+		}
+
+		RecordFilteredEvent(shard.Application.Identifier, event.Type, "middleware")
+
+		return nil
+	}
+
 	packet.Trace.Set("publish", time.Now().UnixNano())
 
 	err = shard.Application.producer.Publish(ctx, shard, packet)
@@ -80,6 +150,8 @@ func (p *EventProviderWithBlacklist) Dispatch(ctx context.Context, shard *Shard,
 		return fmt.Errorf("failed to publish event: %w", err)
 	}
 
+	RecordPublishedBytes(shard.Application.Identifier, event.Type, len(packet.Data))
+
 	if packet != nil {
 		p.producedPayloadPool.Put(packet)
 	} else {
@@ -20,7 +20,9 @@ import (
 	"google.golang.org/grpc"
 )
 
-// Replace this with whatever PUBSUB/implementation you want to use.
+// Replace this with whatever PUBSUB/implementation you want to use. To POST events to an HTTP
+// endpoint instead, use sandwich.NewWebhookProducerProvider or a producer with the webhook type.
+// To run without a broker, use sandwich.NewFileProducerProvider or sandwich.NewSocketProducerProvider.
 
 type (
 	NullProducerProvider struct{}
@@ -147,7 +149,14 @@ func main() {
 	signal.Notify(sig, os.Interrupt)
 	<-sig
 
-	sandwich.Stop(ctx)
+	// Give in-flight events up to 30 seconds to be published before giving up.
+	stopCtx, stopCancel := context.WithTimeout(ctx, time.Second*30)
 
+	report := sandwich.Stop(stopCtx)
+	if report.Dropped() > 0 {
+		slog.Warn("Events were dropped when stopping", "dropped", report.Dropped())
+	}
+
+	stopCancel()
 	cancel()
 }
@@ -0,0 +1,49 @@
+package sandwich
+
+import (
+	"strings"
+	"time"
+
+	"github.com/WelcomerTeam/Discord/discord"
+)
+
+// EventGuildID exposes eventGuildID to tests.
+func EventGuildID(event *discord.GatewayPayload) (discord.Snowflake, bool) {
+	return eventGuildID(event)
+}
+
+// CheckGuildFilter exposes the guild filter of a configuration to tests.
+func CheckGuildFilter(configuration *ApplicationConfiguration, guildID discord.Snowflake) GuildFilterReason {
+	return newGuildFilter(configuration).check(guildID)
+}
+
+// TickHealth records a tick of the health loop.
+func (sandwich *Sandwich) TickHealth() {
+	now := time.Now()
+	sandwich.lastHealthTick.Store(&now)
+}
+
+// IdentifyWindows exposes the windows shards re-identify in to tests.
+func IdentifyWindows(shardIDs []int32, maxConcurrency int32) [][]int32 {
+	return identifyWindows(shardIDs, maxConcurrency)
+}
+
+// RenderPresence exposes renderPresence to tests.
+func RenderPresence(presence discord.UpdateStatus, replacer *strings.Replacer) discord.UpdateStatus {
+	return renderPresence(presence, replacer)
+}
+
+// PresenceRotationRunning reports if presences are being rotated.
+func (application *Application) PresenceRotationRunning() bool {
+	return application.presenceRotationCancel.Load() != nil
+}
+
+// Presence exposes the presence of a shard to tests.
+func (shard *Shard) Presence() discord.UpdateStatus {
+	return shard.presence()
+}
+
+// SetReadsPaused pauses or resumes reads as the spool does.
+func (application *Application) SetReadsPaused(paused bool) {
+	application.setReadsPaused(paused)
+}
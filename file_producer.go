@@ -0,0 +1,342 @@
+package sandwich
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileProducerType is the producer type of file producers, which is registered by default.
+const FileProducerType = "file"
+
+var (
+	// FileProducerBufferSize is the size of the write buffer of file producers.
+	FileProducerBufferSize = 64 * 1024
+	// DefaultFileProducerFlushInterval is how often buffered events are written to the file when FlushIntervalMilliseconds is not set.
+	DefaultFileProducerFlushInterval = time.Second
+)
+
+// FileProducerConfiguration is where and how a file producer writes events.
+type FileProducerConfiguration struct {
+	// Directory is where files are created.
+	Directory string `json:"directory"`
+	// Prefix is the start of the name of each file, followed by the time it was created. Defaults to the application identifier.
+	Prefix string `json:"prefix"`
+	// MaxBytes rotates to a new file once the current file is this large. Files are not rotated by size when 0.
+	MaxBytes int64 `json:"max_bytes"`
+	// RotateIntervalSeconds rotates to a new file once the current file is this old. Files are not rotated by age when 0.
+	RotateIntervalSeconds int32 `json:"rotate_interval_seconds"`
+	// Gzip compresses files once they have been rotated.
+	Gzip bool `json:"gzip"`
+	// FlushIntervalMilliseconds is how often buffered events are written to the file. Defaults to DefaultFileProducerFlushInterval.
+	FlushIntervalMilliseconds int32 `json:"flush_interval_milliseconds"`
+
+	// Format is json, protobuf or msgpack. Defaults to json, which is written as newline delimited JSON.
+	// Other formats are prefixed with their length, see ReadPayloadFrame.
+	Format PayloadFormat `json:"format"`
+}
+
+func (config FileProducerConfiguration) validate() error {
+	if config.Directory == "" {
+		return fmt.Errorf("directory: %w", ErrConfigurationMissingField)
+	}
+
+	if config.MaxBytes < 0 || config.RotateIntervalSeconds < 0 || config.FlushIntervalMilliseconds < 0 {
+		return errors.New("max_bytes, rotate_interval_seconds and flush_interval_milliseconds must not be negative")
+	}
+
+	if _, err := NewPayloadSerializer(config.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FileProducer is a producer that writes events to rotating files.
+type FileProducer struct {
+	logger        *slog.Logger
+	configuration FileProducerConfiguration
+	serializer    PayloadSerializer
+
+	mu       sync.Mutex
+	file     *os.File
+	writer   *bufio.Writer
+	size     int64
+	openedAt time.Time
+	closed   bool
+
+	// compressing tracks rotated files that are being compressed.
+	compressing sync.WaitGroup
+	cancel      context.CancelFunc
+}
+
+// NewFileProducer returns a producer that writes events to files in the configured directory.
+func NewFileProducer(logger *slog.Logger, configuration FileProducerConfiguration) (*FileProducer, error) {
+	if err := configuration.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(configuration.Directory, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	p := &FileProducer{
+		logger:        logger.With("directory", configuration.Directory, "prefix", configuration.Prefix),
+		configuration: configuration,
+	}
+
+	// The format has already been validated.
+	p.serializer, _ = NewPayloadSerializer(configuration.Format)
+
+	if err := p.open(); err != nil {
+		return nil, err
+	}
+
+	interval := DefaultFileProducerFlushInterval
+	if configuration.FlushIntervalMilliseconds > 0 {
+		interval = time.Duration(configuration.FlushIntervalMilliseconds) * time.Millisecond
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	go p.flushEvery(ctx, interval)
+
+	return p, nil
+}
+
+// flushEvery writes buffered events to the file at an interval, so events are not kept in memory
+// for long when few are published.
+func (p *FileProducer) flushEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.Flush(ctx); err != nil {
+				p.logger.Error("Failed to flush file", "error", err)
+			}
+		}
+	}
+}
+
+// open creates a new file. Must be called with the lock held.
+func (p *FileProducer) open() error {
+	openedAt := time.Now().UTC()
+	name := fmt.Sprintf("%s-%s.%s", p.configuration.Prefix, openedAt.Format("20060102T150405.000000000Z"), p.extension())
+
+	file, err := os.OpenFile(filepath.Join(p.configuration.Directory, name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+
+	p.file = file
+	p.writer = bufio.NewWriterSize(file, FileProducerBufferSize)
+	p.size = 0
+	p.openedAt = openedAt
+
+	return nil
+}
+
+// extension returns the file extension of the format.
+func (p *FileProducer) extension() string {
+	switch p.serializer {
+	case ProtobufPayloadSerializer:
+		return "pb"
+	case MsgpackPayloadSerializer:
+		return "msgpack"
+	default:
+		return "ndjson"
+	}
+}
+
+// rotate closes the current file, compressing it if enabled, and opens a new one. Must be called with the lock held.
+func (p *FileProducer) rotate() error {
+	path := p.file.Name()
+
+	if err := p.closeFile(); err != nil {
+		return err
+	}
+
+	if p.configuration.Gzip {
+		p.compressing.Add(1)
+
+		go func() {
+			defer p.compressing.Done()
+
+			if err := compressFile(path); err != nil {
+				p.logger.Error("Failed to compress file", "path", path, "error", err)
+			}
+		}()
+	}
+
+	return p.open()
+}
+
+func (p *FileProducer) closeFile() error {
+	return errors.Join(p.writer.Flush(), p.file.Close())
+}
+
+// shouldRotate returns true if the current file is full or too old. Must be called with the lock held.
+func (p *FileProducer) shouldRotate(size int) bool {
+	if p.size == 0 {
+		return false
+	}
+
+	if p.configuration.MaxBytes > 0 && p.size+int64(size) > p.configuration.MaxBytes {
+		return true
+	}
+
+	if p.configuration.RotateIntervalSeconds > 0 &&
+		time.Since(p.openedAt) >= time.Duration(p.configuration.RotateIntervalSeconds)*time.Second {
+		return true
+	}
+
+	return false
+}
+
+// Publish writes the event to the current file, rotating it first if it is full or too old.
+func (p *FileProducer) Publish(_ context.Context, _ *Shard, payload *ProducedPayload) error {
+	data, err := marshalPayloadFrame(p.serializer, payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return ErrProducerClosed
+	}
+
+	if p.shouldRotate(len(data)) {
+		if err := p.rotate(); err != nil {
+			return fmt.Errorf("failed to rotate file: %w", err)
+		}
+	}
+
+	written, err := p.writer.Write(data)
+	p.size += int64(written)
+
+	if err != nil {
+		return fmt.Errorf("failed to write event: %w", err)
+	}
+
+	return nil
+}
+
+// Flush writes buffered events to the current file.
+func (p *FileProducer) Flush(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil
+	}
+
+	return p.writer.Flush()
+}
+
+// Close closes the current file and waits for rotated files to be compressed. The current file is not compressed.
+func (p *FileProducer) Close() error {
+	p.cancel()
+
+	p.mu.Lock()
+
+	var err error
+
+	if !p.closed {
+		p.closed = true
+		err = p.closeFile()
+	}
+
+	p.mu.Unlock()
+
+	p.compressing.Wait()
+
+	return err
+}
+
+// compressFile replaces a file with a gzip compressed copy.
+func compressFile(path string) error {
+	source, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	temporaryPath := path + ".gz.tmp"
+
+	destination, err := os.OpenFile(temporaryPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o640)
+	if err != nil {
+		return err
+	}
+
+	writer := gzip.NewWriter(destination)
+
+	_, err = io.Copy(writer, source)
+	err = errors.Join(err, writer.Close(), destination.Close())
+
+	if err != nil {
+		_ = os.Remove(temporaryPath)
+
+		return err
+	}
+
+	if err := os.Rename(temporaryPath, path+".gz"); err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
+
+// FileProducerProvider is a ProducerProvider that writes the events of each application to files prefixed with its identifier.
+type FileProducerProvider struct {
+	logger        *slog.Logger
+	configuration FileProducerConfiguration
+}
+
+// NewFileProducerProvider returns a ProducerProvider that creates file producers from the configuration.
+// When the configuration has no prefix, the application identifier is used.
+func NewFileProducerProvider(logger *slog.Logger, configuration FileProducerConfiguration) *FileProducerProvider {
+	return &FileProducerProvider{
+		logger:        logger,
+		configuration: configuration,
+	}
+}
+
+func (p *FileProducerProvider) GetProducer(_ context.Context, applicationIdentifier, _ string) (Producer, error) {
+	configuration := p.configuration
+
+	if configuration.Prefix == "" {
+		configuration.Prefix = applicationIdentifier
+	}
+
+	return NewFileProducer(p.logger, configuration)
+}
+
+func fileProducerFactory(_ context.Context, application *Application, producerConfiguration ProducerConfiguration) (Producer, error) {
+	var configuration FileProducerConfiguration
+
+	if err := json.Unmarshal(producerConfiguration.Options, &configuration); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal file options: %w", err)
+	}
+
+	if configuration.Prefix == "" {
+		configuration.Prefix = application.Identifier
+	}
+
+	return NewFileProducer(application.Logger, configuration)
+}
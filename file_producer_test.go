@@ -0,0 +1,175 @@
+package sandwich_test
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/WelcomerTeam/Discord/discord"
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+// readEventFiles returns the event types in every file in the directory, in the order the files were created.
+func readEventFiles(t *testing.T, directory string, serializer sandwich.PayloadSerializer) []string {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join(directory, "*"))
+	assert.NoError(t, err)
+
+	slices.Sort(paths)
+
+	var eventTypes []string
+
+	for _, path := range paths {
+		file, err := os.Open(path)
+		assert.NoError(t, err)
+
+		var reader io.Reader = file
+
+		if strings.HasSuffix(path, ".gz") {
+			reader, err = gzip.NewReader(file)
+			assert.NoError(t, err)
+		}
+
+		bufferedReader := bufio.NewReader(reader)
+
+		for {
+			var payload sandwich.ProducedPayload
+
+			err := sandwich.ReadPayloadFrame(bufferedReader, serializer, &payload)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			assert.NoError(t, err)
+
+			eventTypes = append(eventTypes, payload.Type)
+		}
+
+		assert.NoError(t, file.Close())
+	}
+
+	return eventTypes
+}
+
+func TestFileProducerRotates(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+
+	producer, err := sandwich.NewFileProducer(slog.Default(), sandwich.FileProducerConfiguration{
+		Directory: directory,
+		Prefix:    "welcomer",
+		MaxBytes:  256,
+		Gzip:      true,
+	})
+	assert.NoError(t, err)
+
+	events := publishSpoolEvents(t, producer, 0, 20)
+
+	assert.NoError(t, producer.Flush(context.Background()))
+	assert.NoError(t, producer.Close())
+	assert.ErrorIs(t, producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{}), sandwich.ErrProducerClosed)
+
+	// Rotated files are compressed, the file that was being written to is not.
+	compressed, err := filepath.Glob(filepath.Join(directory, "welcomer-*.ndjson.gz"))
+	assert.NoError(t, err)
+	assert.Greater(t, len(compressed), 1)
+
+	current, err := filepath.Glob(filepath.Join(directory, "welcomer-*.ndjson"))
+	assert.NoError(t, err)
+	assert.Len(t, current, 1)
+
+	assert.Equal(t, events, readEventFiles(t, directory, sandwich.JSONPayloadSerializer))
+}
+
+func TestFileProducerFlushesPeriodically(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+
+	producer, err := sandwich.NewFileProducer(slog.Default(), sandwich.FileProducerConfiguration{
+		Directory:                 directory,
+		Prefix:                    "welcomer",
+		FlushIntervalMilliseconds: 10,
+	})
+	assert.NoError(t, err)
+
+	defer producer.Close()
+
+	assert.NoError(t, producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: "MESSAGE_CREATE"}}))
+
+	// The event is written without flushing or closing the producer.
+	assert.Eventually(t, func() bool {
+		return slices.Equal([]string{"MESSAGE_CREATE"}, readEventFiles(t, directory, sandwich.JSONPayloadSerializer))
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func TestFileProducerProvider(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+
+	producer, err := sandwich.NewFileProducerProvider(slog.Default(), sandwich.FileProducerConfiguration{Directory: directory}).
+		GetProducer(context.Background(), "welcomer", "")
+	assert.NoError(t, err)
+
+	assert.NoError(t, producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: "MESSAGE_CREATE"}}))
+	assert.NoError(t, producer.Close())
+
+	files, err := filepath.Glob(filepath.Join(directory, "welcomer-*.ndjson"))
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+	assert.Equal(t, []string{"MESSAGE_CREATE"}, readEventFiles(t, directory, sandwich.JSONPayloadSerializer))
+}
+
+func TestFileProducerFormats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		format     sandwich.PayloadFormat
+		serializer sandwich.PayloadSerializer
+		extension  string
+	}{
+		{sandwich.PayloadFormatJSON, sandwich.JSONPayloadSerializer, "ndjson"},
+		{sandwich.PayloadFormatProtobuf, sandwich.ProtobufPayloadSerializer, "pb"},
+		{sandwich.PayloadFormatMsgpack, sandwich.MsgpackPayloadSerializer, "msgpack"},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.format), func(t *testing.T) {
+			t.Parallel()
+
+			directory := t.TempDir()
+
+			producer, err := sandwich.NewFileProducer(slog.Default(), sandwich.FileProducerConfiguration{
+				Directory: directory,
+				Prefix:    "welcomer",
+				Format:    test.format,
+			})
+			assert.NoError(t, err)
+
+			events := publishSpoolEvents(t, producer, 0, 5)
+			assert.NoError(t, producer.Close())
+
+			files, err := filepath.Glob(filepath.Join(directory, "welcomer-*."+test.extension))
+			assert.NoError(t, err)
+			assert.Len(t, files, 1)
+
+			assert.Equal(t, events, readEventFiles(t, directory, test.serializer))
+		})
+	}
+
+	_, err := sandwich.NewFileProducer(slog.Default(), sandwich.FileProducerConfiguration{Directory: t.TempDir(), Format: "xml"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,186 @@
+package sandwich
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/WelcomerTeam/Discord/discord"
+)
+
+var (
+	// GatewayBotMaxAttempts is how many times /gateway/bot is requested before giving up on rate limits and server errors.
+	GatewayBotMaxAttempts = 5
+
+	// GatewayBotRetryAfter is how long to wait before retrying when the response does not say how long to wait.
+	GatewayBotRetryAfter = time.Second * 5
+
+	// GatewayBotMaxRetryAfter is the longest Sandwich will wait before retrying. Longer waits fail immediately.
+	GatewayBotMaxRetryAfter = time.Minute * 5
+)
+
+// RefreshGateway fetches the gateway URL, recommended shard count and session start limits
+// and stores them on the application. It can be called at any time, such as before resharding.
+func (application *Application) RefreshGateway(ctx context.Context) (*discord.GatewayBotResponse, error) {
+	botToken, err := application.ensureBotToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayBotResponse, err := application.fetchGatewayBot(ctx, botToken)
+	if err != nil {
+		return nil, err
+	}
+
+	application.storeGateway(gatewayBotResponse)
+
+	application.Logger.Debug("Refreshed gateway",
+		"shards", gatewayBotResponse.Shards,
+		"session_start_remaining", gatewayBotResponse.SessionStartLimit.Remaining,
+		"session_start_total", gatewayBotResponse.SessionStartLimit.Total,
+		"max_concurrency", gatewayBotResponse.SessionStartLimit.MaxConcurrency,
+	)
+
+	return gatewayBotResponse, nil
+}
+
+// storeGateway stores a gateway response and updates the session start metrics.
+func (application *Application) storeGateway(gatewayBotResponse *discord.GatewayBotResponse) {
+	application.Gateway.Store(gatewayBotResponse)
+	application.gatewaySessionStartLimitRemaining.Store(gatewayBotResponse.SessionStartLimit.Remaining)
+
+	UpdateSessionStartLimit(
+		application.Identifier,
+		gatewayBotResponse.SessionStartLimit.Remaining,
+		gatewayBotResponse.SessionStartLimit.Total,
+		time.Duration(gatewayBotResponse.SessionStartLimit.ResetAfter)*time.Millisecond,
+	)
+}
+
+// fetchGatewayBot fetches the gateway and session start limits using a bot token.
+// Rate limits and server errors are retried, and an invalid token fails immediately.
+func (application *Application) fetchGatewayBot(ctx context.Context, botToken string) (*discord.GatewayBotResponse, error) {
+	application.Sandwich.gatewayLimiter.Lock()
+
+	var lastErr error
+
+	for attempt := 1; attempt <= GatewayBotMaxAttempts; attempt++ {
+		gatewayBotResponse, retryAfter, err := application.doGatewayBotRequest(ctx, botToken)
+		if err == nil {
+			return gatewayBotResponse, nil
+		}
+
+		if retryAfter <= 0 {
+			return nil, err
+		}
+
+		if retryAfter > GatewayBotMaxRetryAfter {
+			return nil, fmt.Errorf("%w: retry after %s is too long", err, retryAfter)
+		}
+
+		lastErr = err
+
+		application.Logger.Warn("Failed to fetch gateway, retrying",
+			"error", err,
+			"attempt", attempt,
+			"retry_after", retryAfter,
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryAfter):
+		}
+	}
+
+	return nil, fmt.Errorf("failed to fetch gateway after %d attempts: %w", GatewayBotMaxAttempts, lastErr)
+}
+
+// doGatewayBotRequest makes a single request to /gateway/bot. If the request can be retried,
+// the returned duration is how long to wait first.
+func (application *Application) doGatewayBotRequest(ctx context.Context, botToken string) (*discord.GatewayBotResponse, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discord.EndpointGatewayBot, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bot "+botToken)
+
+	resp, err := application.Sandwich.Client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to do request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusUnauthorized:
+		return nil, 0, ErrApplicationTokenInvalid
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return nil, gatewayBotRetryAfter(resp), ErrGatewayBotRateLimited
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return nil, GatewayBotRetryAfter, fmt.Errorf("failed to fetch gateway: unexpected status %d", resp.StatusCode)
+	default:
+		return nil, 0, fmt.Errorf("failed to fetch gateway: unexpected status %d", resp.StatusCode)
+	}
+
+	var gatewayBotResponse discord.GatewayBotResponse
+	if err := json.NewDecoder(resp.Body).Decode(&gatewayBotResponse); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode gateway bot response: %w", err)
+	}
+
+	if err := validateGatewayBotResponse(&gatewayBotResponse); err != nil {
+		return nil, 0, err
+	}
+
+	return &gatewayBotResponse, 0, nil
+}
+
+// gatewayBotRetryAfter returns how long a rate limited response asks to wait. The Retry-After header
+// is used first, then the retry_after field of the body.
+func gatewayBotRetryAfter(resp *http.Response) time.Duration {
+	if seconds, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); err == nil && seconds > 0 {
+		return time.Duration(seconds * float64(time.Second))
+	}
+
+	var body struct {
+		RetryAfter float64 `json:"retry_after"`
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if err == nil && json.Unmarshal(data, &body) == nil && body.RetryAfter > 0 {
+		return time.Duration(body.RetryAfter * float64(time.Second))
+	}
+
+	return GatewayBotRetryAfter
+}
+
+// validateGatewayBotResponse checks a gateway response can be used to start shards.
+func validateGatewayBotResponse(gatewayBotResponse *discord.GatewayBotResponse) error {
+	gatewayURL, err := url.Parse(gatewayBotResponse.URL)
+	if err != nil || (gatewayURL.Scheme != "ws" && gatewayURL.Scheme != "wss") || gatewayURL.Host == "" {
+		return fmt.Errorf("%w: invalid url %q", ErrGatewayBotInvalidResponse, gatewayBotResponse.URL)
+	}
+
+	if gatewayBotResponse.Shards < 1 {
+		return fmt.Errorf("%w: invalid shard count %d", ErrGatewayBotInvalidResponse, gatewayBotResponse.Shards)
+	}
+
+	sessionStartLimit := gatewayBotResponse.SessionStartLimit
+
+	if sessionStartLimit.MaxConcurrency < 1 {
+		return fmt.Errorf("%w: invalid max concurrency %d", ErrGatewayBotInvalidResponse, sessionStartLimit.MaxConcurrency)
+	}
+
+	if sessionStartLimit.Remaining < 0 || sessionStartLimit.Remaining > sessionStartLimit.Total {
+		return fmt.Errorf("%w: invalid session start limit %d/%d", ErrGatewayBotInvalidResponse, sessionStartLimit.Remaining, sessionStartLimit.Total)
+	}
+
+	return nil
+}
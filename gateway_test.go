@@ -0,0 +1,111 @@
+package sandwich_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+// redirectTransport sends every request to a test server.
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (transport redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = transport.target.Scheme
+	req.URL.Host = transport.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestApplicationRefreshGateway(t *testing.T) {
+	t.Parallel()
+
+	const validResponse = `{"url":"wss://gateway.discord.gg","shards":2,"session_start_limit":{"total":1000,"remaining":998,"reset_after":1000,"max_concurrency":1}}`
+
+	tests := []struct {
+		name      string
+		responses []func(w http.ResponseWriter)
+		requests  int32
+		err       error
+	}{
+		{
+			name: "ok",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { _, _ = w.Write([]byte(validResponse)) },
+			},
+			requests: 1,
+		},
+		{
+			name: "unauthorized",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusUnauthorized) },
+			},
+			requests: 1,
+			err:      sandwich.ErrApplicationTokenInvalid,
+		},
+		{
+			name: "rate limited then ok",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("Retry-After", "0.01")
+					w.WriteHeader(http.StatusTooManyRequests)
+				},
+				func(w http.ResponseWriter) { _, _ = w.Write([]byte(validResponse)) },
+			},
+			requests: 2,
+		},
+		{
+			name: "empty response",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { _, _ = w.Write([]byte(`{}`)) },
+			},
+			requests: 1,
+			err:      sandwich.ErrGatewayBotInvalidResponse,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bot token", r.Header.Get("Authorization"))
+
+				request := requests.Add(1)
+				test.responses[min(int(request), len(test.responses))-1](w)
+			}))
+			defer server.Close()
+
+			target, err := url.Parse(server.URL)
+			assert.NoError(t, err)
+
+			sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, &http.Client{Transport: redirectTransport{target: target}}, nil, nil, nil, nil, nil)
+			application := sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{
+				ApplicationIdentifier: "test",
+				BotToken:              "token",
+			})
+
+			gatewayBotResponse, err := application.RefreshGateway(context.Background())
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.requests, requests.Load())
+
+			if test.err == nil {
+				assert.Equal(t, int32(2), gatewayBotResponse.Shards)
+				assert.Equal(t, gatewayBotResponse, application.Gateway.Load())
+			} else {
+				assert.Nil(t, application.Gateway.Load())
+			}
+		})
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"slices"
+	"strings"
 
 	"github.com/WelcomerTeam/Discord/discord"
 	sandwich_protobuf "github.com/WelcomerTeam/Sandwich-Daemon/proto"
@@ -33,19 +35,25 @@ func (sandwich *Sandwich) NewGRPCServer() *GRPCServer {
 func (grpcServer *GRPCServer) Listen(req *sandwich_protobuf.ListenRequest, stream sandwich_protobuf.Sandwich_ListenServer) error {
 	RecordGRPCRequest()
 
-	channel := make(chan *listenerData)
+	listener := NewListener(grpcServer.sandwich.listenerBufferSize, grpcServer.sandwich.listenerOverflowPolicy)
 
-	counter := grpcServer.sandwich.AddListener(channel)
+	counter := grpcServer.sandwich.AddListener(listener)
 	defer grpcServer.sandwich.RemoveListener(counter)
 
 	for {
 		select {
 		case <-stream.Context().Done():
 			return nil
-		case data := <-channel:
+		case <-listener.Done():
+			grpcServer.logger.Warn("listener disconnected due to buffer overflow", "identifier", req.GetIdentifier(), "dropped", listener.Dropped())
+
+			return ErrListenerOverflow
+		case data := <-listener.channel:
 			err := stream.Send(&sandwich_protobuf.ListenResponse{
 				Timestamp: data.timestamp.Unix(),
 				Data:      data.payload,
+				Sequence:  data.sequence,
+				Dropped:   listener.Dropped(),
 			})
 			if err != nil {
 				grpcServer.logger.Error("failed to send listen response", "error", err, "identifier", req.GetIdentifier())
@@ -96,10 +104,14 @@ func (grpcServer *GRPCServer) RelayMessage(ctx context.Context, req *sandwich_pr
 }
 
 // ReloadConfiguration implements the ReloadConfiguration RPC method
-func (grpcServer *GRPCServer) ReloadConfiguration(ctx context.Context, req *emptypb.Empty) (*sandwich_protobuf.BaseResponse, error) {
+func (grpcServer *GRPCServer) ReloadConfiguration(ctx context.Context, _ *emptypb.Empty) (*sandwich_protobuf.BaseResponse, error) {
 	RecordGRPCRequest()
 
-	err := grpcServer.sandwich.getConfig(ctx)
+	// Override the context to a background context as applications that are added or restarted
+	// will use the context that is passed to the RPC method which will be cancelled.
+	ctx = context.Background()
+
+	_, err := grpcServer.sandwich.ReloadConfiguration(ctx, false)
 	if err != nil {
 		return &sandwich_protobuf.BaseResponse{
 			Ok:    false,
@@ -113,8 +125,121 @@ func (grpcServer *GRPCServer) ReloadConfiguration(ctx context.Context, req *empt
 	}, nil
 }
 
+// ReconcileConfiguration implements the ReconcileConfiguration RPC method
+func (grpcServer *GRPCServer) ReconcileConfiguration(ctx context.Context, req *sandwich_protobuf.ReconcileConfigurationRequest) (*sandwich_protobuf.ReconcileConfigurationResponse, error) {
+	RecordGRPCRequest()
+
+	// Override the context to a background context as applications that are added or restarted
+	// will use the context that is passed to the RPC method which will be cancelled.
+	ctx = context.Background()
+
+	report, err := grpcServer.sandwich.ReloadConfiguration(ctx, req.GetDryRun())
+	if err != nil {
+		return &sandwich_protobuf.ReconcileConfigurationResponse{
+			BaseResponse: &sandwich_protobuf.BaseResponse{
+				Ok:    false,
+				Error: err.Error(),
+			},
+			DryRun: req.GetDryRun(),
+		}, err
+	}
+
+	return configurationChangeReportToPB(report), nil
+}
+
+// ValidateConfiguration implements the ValidateConfiguration RPC method
+func (grpcServer *GRPCServer) ValidateConfiguration(ctx context.Context, req *sandwich_protobuf.ValidateConfigurationRequest) (*sandwich_protobuf.ValidateConfigurationResponse, error) {
+	RecordGRPCRequest()
+
+	var configuration *Configuration
+
+	if len(req.GetConfiguration()) > 0 {
+		err := json.Unmarshal(req.GetConfiguration(), &configuration)
+		if err != nil {
+			return &sandwich_protobuf.ValidateConfigurationResponse{
+				BaseResponse: &sandwich_protobuf.BaseResponse{
+					Ok:    false,
+					Error: err.Error(),
+				},
+			}, err
+		}
+	} else {
+		var err error
+
+		configuration, err = grpcServer.sandwich.configProvider.GetConfig(ctx)
+		if err != nil {
+			return &sandwich_protobuf.ValidateConfigurationResponse{
+				BaseResponse: &sandwich_protobuf.BaseResponse{
+					Ok:    false,
+					Error: err.Error(),
+				},
+			}, err
+		}
+	}
+
+	// An invalid configuration is not a failed request, the errors are returned in the response.
+	err := ValidateConfiguration(configuration)
+	if err != nil {
+		return configurationErrorsToPB(err), nil
+	}
+
+	return &sandwich_protobuf.ValidateConfigurationResponse{
+		BaseResponse: &sandwich_protobuf.BaseResponse{
+			Ok: true,
+		},
+	}, nil
+}
+
+// ListConfigVersions implements the ListConfigVersions RPC method
+func (grpcServer *GRPCServer) ListConfigVersions(ctx context.Context, _ *emptypb.Empty) (*sandwich_protobuf.ListConfigVersionsResponse, error) {
+	RecordGRPCRequest()
+
+	versionProvider, ok := grpcServer.sandwich.configProvider.(ConfigVersionProvider)
+	if !ok {
+		return &sandwich_protobuf.ListConfigVersionsResponse{
+			BaseResponse: &sandwich_protobuf.BaseResponse{
+				Ok:    false,
+				Error: ErrConfigVersioningUnsupported.Error(),
+			},
+		}, ErrConfigVersioningUnsupported
+	}
+
+	versions, err := versionProvider.ListConfigVersions(ctx)
+	if err != nil {
+		return &sandwich_protobuf.ListConfigVersionsResponse{
+			BaseResponse: &sandwich_protobuf.BaseResponse{
+				Ok:    false,
+				Error: err.Error(),
+			},
+		}, err
+	}
+
+	return configVersionsToPB(versions), nil
+}
+
+// RollbackConfig implements the RollbackConfig RPC method
+func (grpcServer *GRPCServer) RollbackConfig(ctx context.Context, req *sandwich_protobuf.RollbackConfigRequest) (*sandwich_protobuf.ReconcileConfigurationResponse, error) {
+	RecordGRPCRequest()
+
+	// Override the context to a background context as applications that are added or restarted
+	// will use the context that is passed to the RPC method which will be cancelled.
+	ctx = context.Background()
+
+	report, err := grpcServer.sandwich.RollbackConfig(ctx, req.GetVersion())
+	if err != nil {
+		return &sandwich_protobuf.ReconcileConfigurationResponse{
+			BaseResponse: &sandwich_protobuf.BaseResponse{
+				Ok:    false,
+				Error: err.Error(),
+			},
+		}, err
+	}
+
+	return configurationChangeReportToPB(report), nil
+}
+
 // FetchApplication implements the FetchApplication RPC method
-func (grpcServer *GRPCServer) FetchApplication(ctx context.Context, req *sandwich_protobuf.ApplicationIdentifier) (*sandwich_protobuf.FetchApplicationResponse, error) {
+func (grpcServer *GRPCServer) FetchApplication(ctx context.Context, req *sandwich_protobuf.FetchApplicationRequest) (*sandwich_protobuf.FetchApplicationResponse, error) {
 	RecordGRPCRequest()
 
 	applications := make(map[string]*sandwich_protobuf.SandwichApplication)
@@ -124,7 +249,7 @@ func (grpcServer *GRPCServer) FetchApplication(ctx context.Context, req *sandwic
 			return true
 		}
 
-		applications[key] = applicationToPB(application)
+		applications[key] = applicationToPB(application, req.GetIncludeBotToken())
 
 		return true
 	})
@@ -283,6 +408,11 @@ func (grpcServer *GRPCServer) CreateApplication(ctx context.Context, req *sandwi
 		return nil, err
 	}
 
+	// Raw tokens are never written to the configuration file.
+	if req.GetSaveConfig() && !IsSecretReference(applicationConfiguration.BotToken) {
+		return nil, fmt.Errorf("bot token: %w", ErrSecretNotReference)
+	}
+
 	configuration := grpcServer.sandwich.Config.Load()
 
 	// Remove existing application configuration.
@@ -304,13 +434,13 @@ func (grpcServer *GRPCServer) CreateApplication(ctx context.Context, req *sandwi
 	}
 
 	if req.GetSaveConfig() {
-		err := grpcServer.sandwich.configProvider.SaveConfig(ctx, configuration)
+		err := grpcServer.sandwich.configProvider.SaveConfig(WithConfigSaveReason(ctx, "CreateApplication"), configuration)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	return applicationToPB(application), nil
+	return applicationToPB(application, false), nil
 }
 
 // DeleteApplication implements the DeleteApplication RPC method
@@ -337,6 +467,59 @@ func (grpcServer *GRPCServer) DeleteApplication(ctx context.Context, req *sandwi
 	}, nil
 }
 
+// RotateApplicationToken implements the RotateApplicationToken RPC method
+func (grpcServer *GRPCServer) RotateApplicationToken(ctx context.Context, req *sandwich_protobuf.RotateApplicationTokenRequest) (*sandwich_protobuf.BaseResponse, error) {
+	RecordGRPCRequest()
+
+	// Override the context to a background context as shards that reconnect with the new token
+	// will use the context that is passed to the RPC method which will be cancelled.
+	ctx = context.Background()
+
+	if req.GetBotToken() == "" {
+		return &sandwich_protobuf.BaseResponse{
+			Ok:    false,
+			Error: ErrApplicationMissingBotToken.Error(),
+		}, ErrApplicationMissingBotToken
+	}
+
+	err := grpcServer.sandwich.RotateApplicationToken(ctx, req.GetApplicationIdentifier(), req.GetBotToken(), req.GetSaveConfig())
+	if err != nil {
+		return &sandwich_protobuf.BaseResponse{
+			Ok:    false,
+			Error: err.Error(),
+		}, err
+	}
+
+	return &sandwich_protobuf.BaseResponse{
+		Ok: true,
+	}, nil
+}
+
+// ReplayDeadLetters implements the ReplayDeadLetters RPC method
+func (grpcServer *GRPCServer) ReplayDeadLetters(ctx context.Context, req *sandwich_protobuf.ApplicationIdentifier) (*sandwich_protobuf.ReplayDeadLettersResponse, error) {
+	RecordGRPCRequest()
+
+	replayed, failed, err := grpcServer.sandwich.ReplayDeadLetters(ctx, req.GetApplicationIdentifier())
+	if err != nil {
+		return &sandwich_protobuf.ReplayDeadLettersResponse{
+			BaseResponse: &sandwich_protobuf.BaseResponse{
+				Ok:    false,
+				Error: err.Error(),
+			},
+			Replayed: replayed,
+			Failed:   failed,
+		}, err
+	}
+
+	return &sandwich_protobuf.ReplayDeadLettersResponse{
+		BaseResponse: &sandwich_protobuf.BaseResponse{
+			Ok: true,
+		},
+		Replayed: replayed,
+		Failed:   failed,
+	}, nil
+}
+
 // RequestGuildChunk implements the RequestGuildChunk RPC method
 func (grpcServer *GRPCServer) RequestGuildChunk(ctx context.Context, req *sandwich_protobuf.RequestGuildChunkRequest) (*sandwich_protobuf.BaseResponse, error) {
 	RecordGRPCRequest()
@@ -412,6 +595,55 @@ func (grpcServer *GRPCServer) SendWebsocketMessage(ctx context.Context, req *san
 	}, nil
 }
 
+// DiscordREST implements the DiscordREST RPC method
+func (grpcServer *GRPCServer) DiscordREST(ctx context.Context, req *sandwich_protobuf.DiscordRESTRequest) (*sandwich_protobuf.DiscordRESTResponse, error) {
+	RecordGRPCRequest()
+
+	application, ok := grpcServer.sandwich.Applications.Load(req.GetApplicationIdentifier())
+	if !ok {
+		return &sandwich_protobuf.DiscordRESTResponse{
+			BaseResponse: &sandwich_protobuf.BaseResponse{
+				Ok:    false,
+				Error: ErrApplicationNotFound.Error(),
+			},
+		}, ErrApplicationNotFound
+	}
+
+	headers := make(http.Header, len(req.GetHeaders()))
+	for key, value := range req.GetHeaders() {
+		headers.Set(key, value)
+	}
+
+	restResponse, err := application.DoREST(ctx, &RESTRequest{
+		Method:  req.GetMethod(),
+		Path:    req.GetPath(),
+		Body:    req.GetBody(),
+		Headers: headers,
+	})
+	if err != nil {
+		return &sandwich_protobuf.DiscordRESTResponse{
+			BaseResponse: &sandwich_protobuf.BaseResponse{
+				Ok:    false,
+				Error: err.Error(),
+			},
+		}, err
+	}
+
+	responseHeaders := make(map[string]string, len(restResponse.Headers))
+	for key, values := range restResponse.Headers {
+		responseHeaders[key] = strings.Join(values, ", ")
+	}
+
+	return &sandwich_protobuf.DiscordRESTResponse{
+		BaseResponse: &sandwich_protobuf.BaseResponse{
+			Ok: true,
+		},
+		StatusCode: int32(restResponse.StatusCode),
+		Headers:    responseHeaders,
+		Body:       restResponse.Body,
+	}, nil
+}
+
 // WhereIsGuild implements the WhereIsGuild RPC method
 func (grpcServer *GRPCServer) WhereIsGuild(ctx context.Context, req *sandwich_protobuf.WhereIsGuildRequest) (*sandwich_protobuf.WhereIsGuildResponse, error) {
 	RecordGRPCRequest()
@@ -0,0 +1,148 @@
+package sandwich
+
+import (
+	"encoding/json"
+
+	"github.com/WelcomerTeam/Discord/discord"
+)
+
+// GuildFilterReason is why an event was filtered by the guild allow or deny list.
+type GuildFilterReason string
+
+const (
+	GuildFilterReasonNone      GuildFilterReason = ""
+	GuildFilterReasonAllowList GuildFilterReason = "guild_allow_list"
+	GuildFilterReasonDenyList  GuildFilterReason = "guild_deny_list"
+)
+
+// guildFilter is the compiled guild allow and deny lists of an application configuration.
+type guildFilter struct {
+	allow map[discord.Snowflake]struct{}
+	deny  map[discord.Snowflake]struct{}
+
+	skipState bool
+}
+
+func newGuildFilter(configuration *ApplicationConfiguration) *guildFilter {
+	filter := &guildFilter{
+		allow: nil,
+		deny:  nil,
+
+		skipState: configuration.GuildFilterSkipState,
+	}
+
+	if len(configuration.GuildAllowList) > 0 {
+		filter.allow = make(map[discord.Snowflake]struct{}, len(configuration.GuildAllowList))
+		for _, guildID := range configuration.GuildAllowList {
+			filter.allow[guildID] = struct{}{}
+		}
+	}
+
+	if len(configuration.GuildDenyList) > 0 {
+		filter.deny = make(map[discord.Snowflake]struct{}, len(configuration.GuildDenyList))
+		for _, guildID := range configuration.GuildDenyList {
+			filter.deny[guildID] = struct{}{}
+		}
+	}
+
+	return filter
+}
+
+// enabled returns true if the filter has an allow or deny list.
+func (filter *guildFilter) enabled() bool {
+	return filter.allow != nil || filter.deny != nil
+}
+
+// check returns why an event for the guild should be filtered, if it should be.
+func (filter *guildFilter) check(guildID discord.Snowflake) GuildFilterReason {
+	if _, ok := filter.deny[guildID]; ok {
+		return GuildFilterReasonDenyList
+	}
+
+	if filter.allow != nil {
+		if _, ok := filter.allow[guildID]; !ok {
+			return GuildFilterReasonAllowList
+		}
+	}
+
+	return GuildFilterReasonNone
+}
+
+// filterGuildEvent returns why an event should not be produced because of the guild allow and deny
+// lists, and whether state updates should be skipped too. Events that do not belong to a guild are never filtered.
+func (application *Application) filterGuildEvent(event *discord.GatewayPayload) (reason GuildFilterReason, skipState bool) {
+	filter := application.compiledConfiguration().guildFilter
+	if !filter.enabled() {
+		return GuildFilterReasonNone, false
+	}
+
+	guildID, ok := eventGuildID(event)
+	if !ok {
+		return GuildFilterReasonNone, false
+	}
+
+	reason = filter.check(guildID)
+	if reason == GuildFilterReasonNone {
+		return GuildFilterReasonNone, false
+	}
+
+	return reason, filter.skipState
+}
+
+// eventGuildID returns the guild an event belongs to. Guild events use the id field and other events use guild_id.
+func eventGuildID(event *discord.GatewayPayload) (discord.Snowflake, bool) {
+	switch event.Type {
+	case discord.DiscordEventGuildCreate, discord.DiscordEventGuildUpdate, discord.DiscordEventGuildDelete:
+		var payload struct {
+			ID discord.Snowflake `json:"id"`
+		}
+
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return 0, false
+		}
+
+		return payload.ID, payload.ID != 0
+	}
+
+	var payload struct {
+		GuildID discord.Snowflake `json:"guild_id"`
+	}
+
+	if err := json.Unmarshal(event.Data, &payload); err != nil {
+		return 0, false
+	}
+
+	return payload.GuildID, payload.GuildID != 0
+}
+
+// trackFilteredGuild keeps the guilds of the shard up to date for guild events that are filtered without
+// updating state, so lazy guilds are still marked as loaded and guilds the bot has left are removed.
+func (shard *Shard) trackFilteredGuild(event *discord.GatewayPayload) {
+	if event.Type != discord.DiscordEventGuildCreate && event.Type != discord.DiscordEventGuildDelete {
+		return
+	}
+
+	var payload struct {
+		ID          discord.Snowflake `json:"id"`
+		Unavailable bool              `json:"unavailable"`
+	}
+
+	if err := json.Unmarshal(event.Data, &payload); err != nil {
+		return
+	}
+
+	if event.Type == discord.DiscordEventGuildCreate {
+		shard.Guilds.Store(payload.ID, true)
+		shard.LazyGuilds.Delete(payload.ID)
+		shard.UnavailableGuilds.Delete(payload.ID)
+
+		return
+	}
+
+	if payload.Unavailable {
+		shard.UnavailableGuilds.Store(payload.ID, true)
+	} else {
+		shard.Guilds.Delete(payload.ID)
+		shard.Application.guilds.Delete(payload.ID)
+	}
+}
@@ -0,0 +1,112 @@
+package sandwich_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/WelcomerTeam/Discord/discord"
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuildFilterCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		configuration *sandwich.ApplicationConfiguration
+		guildID       discord.Snowflake
+		reason        sandwich.GuildFilterReason
+	}{
+		{"no lists", &sandwich.ApplicationConfiguration{}, 1, sandwich.GuildFilterReasonNone},
+		{"allowed", &sandwich.ApplicationConfiguration{GuildAllowList: []discord.Snowflake{1}}, 1, sandwich.GuildFilterReasonNone},
+		{"not allowed", &sandwich.ApplicationConfiguration{GuildAllowList: []discord.Snowflake{1}}, 2, sandwich.GuildFilterReasonAllowList},
+		{"denied", &sandwich.ApplicationConfiguration{GuildDenyList: []discord.Snowflake{1}}, 1, sandwich.GuildFilterReasonDenyList},
+		{"not denied", &sandwich.ApplicationConfiguration{GuildDenyList: []discord.Snowflake{1}}, 2, sandwich.GuildFilterReasonNone},
+		{"allowed and denied", &sandwich.ApplicationConfiguration{
+			GuildAllowList: []discord.Snowflake{1},
+			GuildDenyList:  []discord.Snowflake{1},
+		}, 1, sandwich.GuildFilterReasonDenyList},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.reason, sandwich.CheckGuildFilter(test.configuration, test.guildID))
+		})
+	}
+}
+
+func TestEventGuildID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		eventType string
+		data      string
+		guildID   discord.Snowflake
+		ok        bool
+	}{
+		{discord.DiscordEventGuildCreate, `{"id":"1","name":"guild"}`, 1, true},
+		{discord.DiscordEventGuildUpdate, `{"id":"1"}`, 1, true},
+		{discord.DiscordEventGuildDelete, `{"id":"1","unavailable":true}`, 1, true},
+		{discord.DiscordEventMessageCreate, `{"id":"2","guild_id":"1"}`, 1, true},
+		{discord.DiscordEventMessageCreate, `{"id":"2"}`, 0, false},
+		{discord.DiscordEventTypingStart, `not json`, 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.eventType+" "+test.data, func(t *testing.T) {
+			t.Parallel()
+
+			guildID, ok := sandwich.EventGuildID(&discord.GatewayPayload{Type: test.eventType, Data: json.RawMessage(test.data)})
+			assert.Equal(t, test.guildID, guildID)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+}
+
+func TestGuildFilterSkipStateTracksGuilds(t *testing.T) {
+	t.Parallel()
+
+	eventProvider := sandwich.NewEventProviderWithBlacklist(nil)
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, eventProvider, nil, nil, nil, nil)
+
+	application := sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{
+		ApplicationIdentifier: "welcomer",
+		GuildDenyList:         []discord.Snowflake{1, 2},
+		GuildFilterSkipState:  true,
+	})
+
+	shard := sandwich.NewShard(sandwichInstance, application, 0)
+	shard.LazyGuilds.Store(1, true)
+	shard.Guilds.Store(1, true)
+	shard.Guilds.Store(2, true)
+
+	dispatch := func(eventType, data string) {
+		err := eventProvider.Dispatch(context.Background(), shard, &discord.GatewayPayload{
+			Op:   discord.GatewayOpDispatch,
+			Type: eventType,
+			Data: json.RawMessage(data),
+		}, nil)
+		assert.NoError(t, err)
+	}
+
+	// Lazy guilds are loaded even though their state is skipped.
+	dispatch(discord.DiscordEventGuildCreate, `{"id":"1"}`)
+	assert.Equal(t, 0, shard.LazyGuilds.Count())
+
+	dispatch(discord.DiscordEventGuildDelete, `{"id":"2","unavailable":true}`)
+	_, unavailable := shard.UnavailableGuilds.Load(2)
+	assert.True(t, unavailable)
+
+	// Guilds the bot has left are removed.
+	dispatch(discord.DiscordEventGuildDelete, `{"id":"2"}`)
+	_, exists := shard.Guilds.Load(2)
+	assert.False(t, exists)
+
+	_, exists = shard.Guilds.Load(1)
+	assert.True(t, exists)
+}
@@ -0,0 +1,318 @@
+package sandwich
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	pb "github.com/WelcomerTeam/Sandwich-Daemon/proto"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var (
+	// HealthLoopInterval is how often the health loop ticks.
+	HealthLoopInterval = time.Second
+	// HealthLoopStaleAfter is how long since the last tick before the process is considered unhealthy.
+	HealthLoopStaleAfter = time.Second * 10
+	// HealthShardStaleAfter is how long a connected shard can go without a heartbeat ack before it is considered
+	// wedged, when its heartbeat interval is not known yet.
+	HealthShardStaleAfter = time.Minute * 5
+	// HealthMaxWedgedShardPercentage is the percentage of connected shards, from 0 to 100, that can be wedged
+	// before the process is considered unhealthy. Wedged shards are always listed in the liveness report.
+	HealthMaxWedgedShardPercentage = 50.0
+)
+
+// ReadinessMode decides how readiness is determined.
+type ReadinessMode int32
+
+const (
+	// ReadinessAutoStartApplications is ready when every auto-start application is ready.
+	ReadinessAutoStartApplications ReadinessMode = iota
+	// ReadinessShardPercentage is ready when at least MinimumShardPercentage of all shards are ready.
+	ReadinessShardPercentage
+)
+
+func (mode ReadinessMode) String() string {
+	switch mode {
+	case ReadinessAutoStartApplications:
+		return "AutoStartApplications"
+	case ReadinessShardPercentage:
+		return "ShardPercentage"
+	default:
+		return fmt.Sprintf("ReadinessMode(%d)", int32(mode))
+	}
+}
+
+type ReadinessPolicy struct {
+	Mode ReadinessMode
+	// MinimumShardPercentage is the percentage of shards, from 0 to 100, that must be ready
+	// when using ReadinessShardPercentage.
+	MinimumShardPercentage float64
+}
+
+type LivenessReport struct {
+	Alive    bool      `json:"alive"`
+	LastTick time.Time `json:"last_tick"`
+	Reason   string    `json:"reason,omitempty"`
+	// WedgedShards are connected shards that have stopped receiving heartbeat acks, as identifier/shard_id.
+	WedgedShards []string `json:"wedged_shards,omitempty"`
+}
+
+type ReadinessReport struct {
+	Ready        bool                         `json:"ready"`
+	Mode         string                       `json:"mode"`
+	Reason       string                       `json:"reason,omitempty"`
+	ShardsReady  int                          `json:"shards_ready"`
+	ShardsTotal  int                          `json:"shards_total"`
+	Applications map[string]ApplicationHealth `json:"applications"`
+}
+
+type ApplicationHealth struct {
+	Status    string        `json:"status"`
+	AutoStart bool          `json:"auto_start"`
+	Ready     bool          `json:"ready"`
+	Shards    []ShardHealth `json:"shards"`
+}
+
+type ShardHealth struct {
+	ShardID          int32      `json:"shard_id"`
+	Status           string     `json:"status"`
+	Ready            bool       `json:"ready"`
+	LastHeartbeatAck *time.Time `json:"last_heartbeat_ack,omitempty"`
+	GatewayLatency   int64      `json:"gateway_latency"`
+}
+
+// WithReadinessPolicy configures how readiness is determined for /readyz and the gRPC health service.
+// Unknown modes fall back to ReadinessAutoStartApplications and the percentage is clamped from 0 to 100.
+func (sandwich *Sandwich) WithReadinessPolicy(policy ReadinessPolicy) *Sandwich {
+	if policy.Mode != ReadinessAutoStartApplications && policy.Mode != ReadinessShardPercentage {
+		sandwich.Logger.Warn("Unknown readiness mode, using AutoStartApplications", "mode", policy.Mode.String())
+
+		policy.Mode = ReadinessAutoStartApplications
+	}
+
+	policy.MinimumShardPercentage = min(max(policy.MinimumShardPercentage, 0), 100)
+
+	sandwich.readinessPolicy.Store(&policy)
+
+	return sandwich
+}
+
+// Liveness reports if the process is responsive, by checking the health loop has recently ticked and
+// that no more than HealthMaxWedgedShardPercentage of connected shards have stopped receiving heartbeat acks.
+// Applications with paused reads are not checked.
+func (sandwich *Sandwich) Liveness() LivenessReport {
+	report := LivenessReport{
+		Alive: true,
+	}
+
+	if lastTick := sandwich.lastHealthTick.Load(); lastTick != nil {
+		report.LastTick = *lastTick
+	}
+
+	if age := time.Since(report.LastTick); age > HealthLoopStaleAfter {
+		report.Alive = false
+		report.Reason = fmt.Sprintf("health loop has not ticked for %s", age.Round(time.Second))
+	}
+
+	connectedShards := 0
+
+	sandwich.Applications.Range(func(identifier string, application *Application) bool {
+		// Shards do not read while the spool has paused reads, so heartbeat acks are expected to stop.
+		if application.readsPaused() {
+			return true
+		}
+
+		application.Shards.Range(func(shardID int32, shard *Shard) bool {
+			switch ShardStatus(shard.Status.Load()) {
+			case ShardStatusConnected, ShardStatusReady:
+				connectedShards++
+			}
+
+			if shard.wedged() {
+				report.WedgedShards = append(report.WedgedShards, fmt.Sprintf("%s/%d", identifier, shardID))
+			}
+
+			return true
+		})
+
+		return true
+	})
+
+	// A few wedged shards do not warrant restarting every other shard of the process.
+	if len(report.WedgedShards) > 0 && report.Alive {
+		wedgedPercentage := float64(len(report.WedgedShards)) / float64(connectedShards) * 100
+
+		if wedgedPercentage > HealthMaxWedgedShardPercentage {
+			report.Alive = false
+			report.Reason = fmt.Sprintf("%.0f%% of shards have stopped receiving heartbeat acks: %v", wedgedPercentage, report.WedgedShards)
+		}
+	}
+
+	return report
+}
+
+// wedged returns true if the shard is connected but has not received a heartbeat ack for much longer than
+// it takes the shard to notice and reconnect by itself, meaning its heartbeat or read loop is stuck.
+func (shard *Shard) wedged() bool {
+	switch ShardStatus(shard.Status.Load()) {
+	case ShardStatusConnected, ShardStatusReady:
+	default:
+		return false
+	}
+
+	lastHeartbeatAck := shard.LastHeartbeatAck.Load()
+	if lastHeartbeatAck == nil {
+		return false
+	}
+
+	staleAfter := HealthShardStaleAfter
+	if heartbeatFailureInterval := shard.heartbeatFailureInterval.Load(); heartbeatFailureInterval != nil {
+		staleAfter = *heartbeatFailureInterval * 2
+	}
+
+	return time.Since(*lastHeartbeatAck) > staleAfter
+}
+
+// Readiness reports if sandwich is ready according to the readiness policy.
+func (sandwich *Sandwich) Readiness() ReadinessReport {
+	policy := sandwich.readinessPolicy.Load()
+
+	report := ReadinessReport{
+		Ready:        true,
+		Mode:         policy.Mode.String(),
+		Applications: make(map[string]ApplicationHealth),
+	}
+
+	var notReady []string
+
+	sandwich.Applications.Range(func(identifier string, application *Application) bool {
+		applicationStatus := ApplicationStatus(application.Status.Load())
+
+		applicationHealth := ApplicationHealth{
+			Status:    applicationStatus.String(),
+			AutoStart: application.Configuration.Load().AutoStart,
+			Ready:     applicationStatus == ApplicationStatusReady,
+			Shards:    make([]ShardHealth, 0),
+		}
+
+		application.Shards.Range(func(shardID int32, shard *Shard) bool {
+			shardStatus := ShardStatus(shard.Status.Load())
+
+			applicationHealth.Shards = append(applicationHealth.Shards, ShardHealth{
+				ShardID:          shardID,
+				Status:           shardStatus.String(),
+				Ready:            shardStatus == ShardStatusReady,
+				LastHeartbeatAck: shard.LastHeartbeatAck.Load(),
+				GatewayLatency:   shard.GatewayLatency.Load(),
+			})
+
+			report.ShardsTotal++
+
+			if shardStatus == ShardStatusReady {
+				report.ShardsReady++
+			}
+
+			return true
+		})
+
+		if applicationHealth.AutoStart && !applicationHealth.Ready {
+			notReady = append(notReady, identifier)
+		}
+
+		report.Applications[identifier] = applicationHealth
+
+		return true
+	})
+
+	switch policy.Mode {
+	case ReadinessAutoStartApplications:
+		if len(notReady) > 0 {
+			report.Ready = false
+			report.Reason = fmt.Sprintf("auto-start applications are not ready: %v", notReady)
+		}
+	case ReadinessShardPercentage:
+		switch {
+		case report.ShardsTotal == 0:
+			report.Ready = false
+			report.Reason = "no shards are running"
+		case float64(report.ShardsReady)/float64(report.ShardsTotal)*100 < policy.MinimumShardPercentage:
+			report.Ready = false
+			report.Reason = fmt.Sprintf("%d of %d shards are ready, %.1f%% required",
+				report.ShardsReady, report.ShardsTotal, policy.MinimumShardPercentage)
+		}
+	}
+
+	return report
+}
+
+// runHealthLoop ticks to show the process is responsive and keeps the gRPC health service up to date.
+func (sandwich *Sandwich) runHealthLoop(ctx context.Context) {
+	ticker := time.NewTicker(HealthLoopInterval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now()
+		sandwich.lastHealthTick.Store(&now)
+
+		if healthServer := sandwich.healthServer.Load(); healthServer != nil {
+			status := healthpb.HealthCheckResponse_NOT_SERVING
+			if sandwich.Readiness().Ready {
+				status = healthpb.HealthCheckResponse_SERVING
+			}
+
+			healthServer.SetServingStatus("", status)
+			healthServer.SetServingStatus(pb.Sandwich_ServiceDesc.ServiceName, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (sandwich *Sandwich) registerGRPCHealth() *health.Server {
+	healthServer := health.NewServer()
+
+	// Not serving until the health loop has checked readiness.
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthServer.SetServingStatus(pb.Sandwich_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	sandwich.healthServer.Store(healthServer)
+
+	return healthServer
+}
+
+func (sandwich *Sandwich) handleHealthz(w http.ResponseWriter, _ *http.Request) {
+	report := sandwich.Liveness()
+
+	statusCode := http.StatusOK
+	if !report.Alive {
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, statusCode, report)
+}
+
+func (sandwich *Sandwich) handleReadyz(w http.ResponseWriter, _ *http.Request) {
+	report := sandwich.Readiness()
+
+	statusCode := http.StatusOK
+	if !report.Ready {
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, statusCode, report)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	_ = json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,99 @@
+package sandwich_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLivenessReportsWedgedShards(t *testing.T) {
+	t.Parallel()
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil)
+
+	application := sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{ApplicationIdentifier: "welcomer"})
+	sandwichInstance.Applications.Store("welcomer", application)
+
+	now := time.Now()
+	stale := now.Add(-time.Hour)
+
+	for shardID, lastHeartbeatAck := range []time.Time{now, stale, now, now} {
+		shard := sandwich.NewShard(sandwichInstance, application, int32(shardID))
+		shard.Status.Store(int32(sandwich.ShardStatusReady))
+		shard.LastHeartbeatAck.Store(&lastHeartbeatAck)
+
+		application.Shards.Store(int32(shardID), shard)
+	}
+
+	// A stopped shard is not expected to receive heartbeat acks.
+	stopped := sandwich.NewShard(sandwichInstance, application, 4)
+	stopped.Status.Store(int32(sandwich.ShardStatusStopped))
+	stopped.LastHeartbeatAck.Store(&stale)
+	application.Shards.Store(4, stopped)
+
+	sandwichInstance.TickHealth()
+
+	// One wedged shard is reported without failing liveness.
+	report := sandwichInstance.Liveness()
+	assert.True(t, report.Alive)
+	assert.Equal(t, []string{"welcomer/1"}, report.WedgedShards)
+
+	// Once most shards are wedged, the process is no longer alive.
+	for _, shardID := range []int32{2, 3} {
+		shard, _ := application.Shards.Load(shardID)
+		shard.LastHeartbeatAck.Store(&stale)
+	}
+
+	report = sandwichInstance.Liveness()
+	assert.False(t, report.Alive)
+	assert.Len(t, report.WedgedShards, 3)
+}
+
+func TestLivenessIgnoresPausedApplications(t *testing.T) {
+	t.Parallel()
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil)
+
+	application := sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{ApplicationIdentifier: "welcomer"})
+	sandwichInstance.Applications.Store("welcomer", application)
+
+	stale := time.Now().Add(-time.Hour)
+
+	for shardID := range int32(2) {
+		shard := sandwich.NewShard(sandwichInstance, application, shardID)
+		shard.Status.Store(int32(sandwich.ShardStatusReady))
+		shard.LastHeartbeatAck.Store(&stale)
+
+		application.Shards.Store(shardID, shard)
+	}
+
+	sandwichInstance.TickHealth()
+
+	// Shards stop reading while the spool is full, so they are not wedged.
+	application.SetReadsPaused(true)
+
+	report := sandwichInstance.Liveness()
+	assert.True(t, report.Alive)
+	assert.Empty(t, report.WedgedShards)
+
+	application.SetReadsPaused(false)
+
+	report = sandwichInstance.Liveness()
+	assert.False(t, report.Alive)
+	assert.Len(t, report.WedgedShards, 2)
+}
+
+func TestReadinessPolicyUnknownMode(t *testing.T) {
+	t.Parallel()
+
+	mode := sandwich.ReadinessMode(5)
+	assert.Equal(t, "ReadinessMode(5)", mode.String())
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil).
+		WithReadinessPolicy(sandwich.ReadinessPolicy{Mode: mode})
+
+	assert.Equal(t, sandwich.ReadinessAutoStartApplications.String(), sandwichInstance.Readiness().Mode)
+}
@@ -0,0 +1,385 @@
+package sandwich
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+
+	pb "github.com/WelcomerTeam/Sandwich-Daemon/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// HTTPAPIPrefix is the path the HTTP API is served under. Methods are available at HTTPAPIPrefix + method name,
+// for example POST /api/v1/StartApplication.
+const HTTPAPIPrefix = "/api/v1/"
+
+// HTTPAPIMaxBodySize is the largest request body the HTTP API will accept.
+var HTTPAPIMaxBodySize int64 = 4 << 20
+
+// HTTPAPI serves the unary gRPC methods as JSON over HTTP. Requests and responses use the protobuf
+// JSON mapping of the same messages, and are handled by the same GRPCServer methods.
+type HTTPAPI struct {
+	sandwich *Sandwich
+
+	logger     *slog.Logger
+	grpcServer *GRPCServer
+
+	methods map[string]grpc.MethodDesc
+
+	interceptor grpc.UnaryServerInterceptor
+}
+
+// NewHTTPAPI returns a handler for the HTTP API. It can be mounted on any mux at HTTPAPIPrefix.
+func (sandwich *Sandwich) NewHTTPAPI() *HTTPAPI {
+	methods := make(map[string]grpc.MethodDesc, len(pb.Sandwich_ServiceDesc.Methods))
+
+	for _, method := range pb.Sandwich_ServiceDesc.Methods {
+		methods[method.MethodName] = method
+	}
+
+	return &HTTPAPI{
+		sandwich: sandwich,
+
+		logger:     sandwich.Logger.With("service", "http_api"),
+		grpcServer: sandwich.NewGRPCServer(),
+
+		methods: methods,
+
+		interceptor: nil,
+	}
+}
+
+// WithUnaryInterceptor sets an interceptor that is called for every request, as it would be with gRPC.
+// When no interceptor is set, the interceptor of the authenticator set with WithAuthenticator is used.
+func (api *HTTPAPI) WithUnaryInterceptor(interceptor grpc.UnaryServerInterceptor) *HTTPAPI {
+	api.interceptor = interceptor
+
+	return api
+}
+
+// WithHTTPAPI serves the HTTP API on a server. If the server was passed to WithPrometheusAnalytics, the API
+// is added to the same ServeMux, regardless of which is called first. If the server already uses a ServeMux
+// of its own, the API is added to it and the server is not started. Otherwise, the server is started with only the API.
+func (sandwich *Sandwich) WithHTTPAPI(server *http.Server) *Sandwich {
+	api := sandwich.NewHTTPAPI()
+
+	sandwich.httpServersMu.Lock()
+	_, started := sandwich.httpServers[server]
+	sandwich.httpServersMu.Unlock()
+
+	if mux, ok := server.Handler.(*http.ServeMux); ok && !started {
+		mux.Handle(HTTPAPIPrefix, api)
+
+		return sandwich
+	}
+
+	sandwich.serveHTTP(server, "HTTP API").Handle(HTTPAPIPrefix, api)
+
+	return sandwich
+}
+
+func (api *HTTPAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	methodName := strings.TrimPrefix(r.URL.Path, HTTPAPIPrefix)
+
+	method, ok := api.methods[methodName]
+	if !ok {
+		writeHTTPAPIError(w, http.StatusNotFound, fmt.Errorf("unknown method %q", methodName))
+
+		return
+	}
+
+	var body []byte
+
+	switch r.Method {
+	case http.MethodPost:
+		if !isJSONContentType(r.Header.Get("Content-Type")) {
+			writeHTTPAPIError(w, http.StatusUnsupportedMediaType, errors.New("content type must be application/json"))
+
+			return
+		}
+
+		var err error
+
+		body, err = io.ReadAll(http.MaxBytesReader(w, r.Body, HTTPAPIMaxBodySize))
+		if err != nil {
+			writeHTTPAPIError(w, http.StatusRequestEntityTooLarge, err)
+
+			return
+		}
+	case http.MethodGet:
+		// Only methods that do not change anything can be called with GET, so they can be used from a browser.
+		if !isReadOnlyMethod(methodName) {
+			w.Header().Set("Allow", http.MethodPost)
+			writeHTTPAPIError(w, http.StatusMethodNotAllowed, fmt.Errorf("%s must be called with POST", methodName))
+
+			return
+		}
+	default:
+		w.Header().Set("Allow", http.MethodPost)
+		writeHTTPAPIError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+
+		return
+	}
+
+	decode := func(req any) error {
+		message, ok := req.(proto.Message)
+		if !ok {
+			return fmt.Errorf("unexpected request type %T", req)
+		}
+
+		if r.Method == http.MethodGet {
+			return decodeQuery(r, message)
+		}
+
+		if len(body) == 0 {
+			return nil
+		}
+
+		return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, message)
+	}
+
+	resp, err := method.Handler(api.grpcServer, httpAPIContext(r), httpAPIDecoder(decode), api.unaryInterceptor())
+	if err != nil {
+		api.logger.Debug("HTTP API request failed", "method", methodName, "error", err)
+
+		statusCode := httpStatusFromError(err)
+
+		// Responses that contain a base response are written as-is so the error is in the same place as with gRPC.
+		if message, ok := resp.(proto.Message); ok && !isNilMessage(message) {
+			writeHTTPAPIMessage(w, statusCode, message)
+		} else {
+			writeHTTPAPIError(w, statusCode, err)
+		}
+
+		return
+	}
+
+	message, ok := resp.(proto.Message)
+	if !ok {
+		writeHTTPAPIError(w, http.StatusInternalServerError, fmt.Errorf("unexpected response type %T", resp))
+
+		return
+	}
+
+	writeHTTPAPIMessage(w, http.StatusOK, message)
+}
+
+// unaryInterceptor returns the interceptor for a request. The authenticator is looked up on each request
+// so it does not matter whether WithAuthenticator is called before or after the API is created.
+func (api *HTTPAPI) unaryInterceptor() grpc.UnaryServerInterceptor {
+	if api.interceptor != nil {
+		return api.interceptor
+	}
+
+	if authenticator := api.sandwich.authenticator.Load(); authenticator != nil {
+		return authenticator.UnaryServerInterceptor()
+	}
+
+	return nil
+}
+
+// isJSONContentType reports if a content type is application/json. Requiring it prevents browsers from sending
+// requests to the API from other sites without a preflight request.
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+
+	return err == nil && mediaType == "application/json"
+}
+
+// httpAPIContext passes the authorization header and client certificate of a request
+// the same way gRPC does, so interceptors can be shared.
+func httpAPIContext(r *http.Request) context.Context {
+	ctx := r.Context()
+
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+	}
+
+	if r.TLS != nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{
+			Addr:     httpRemoteAddr(r.RemoteAddr),
+			AuthInfo: credentials.TLSInfo{State: *r.TLS},
+		})
+	}
+
+	return ctx
+}
+
+type httpRemoteAddr string
+
+func (addr httpRemoteAddr) Network() string {
+	return "tcp"
+}
+
+func (addr httpRemoteAddr) String() string {
+	return string(addr)
+}
+
+// httpAPIDecoder wraps decoding errors so they are reported as bad requests.
+func httpAPIDecoder(decode func(req any) error) func(req any) error {
+	return func(req any) error {
+		if err := decode(req); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil
+	}
+}
+
+// decodeQuery decodes query parameters into a request. Repeated parameters become lists.
+func decodeQuery(r *http.Request, message proto.Message) error {
+	fields := make(map[string]any)
+
+	for key, values := range r.URL.Query() {
+		if len(values) == 1 {
+			fields[key] = queryValue(values[0])
+
+			continue
+		}
+
+		list := make([]any, 0, len(values))
+		for _, value := range values {
+			list = append(list, queryValue(value))
+		}
+
+		fields[key] = list
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, message)
+}
+
+// queryValue returns booleans as booleans. Numbers are left as strings, which the protobuf JSON mapping accepts.
+func queryValue(value string) any {
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	return value
+}
+
+func isReadOnlyMethod(methodName string) bool {
+	return strings.HasPrefix(methodName, "Fetch") ||
+		strings.HasPrefix(methodName, "List") ||
+		methodName == "WhereIsGuild" ||
+		methodName == "ValidateConfiguration"
+}
+
+func isNilMessage(message proto.Message) bool {
+	return message == nil || !message.ProtoReflect().IsValid()
+}
+
+// httpStatusFromError maps the errors returned by the gRPC methods to HTTP status codes.
+func httpStatusFromError(err error) int {
+	var configurationErrors ConfigurationErrors
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, ErrApplicationNotFound),
+		errors.Is(err, ErrGuildNotFound),
+		errors.Is(err, ErrShardNotFound),
+		errors.Is(err, ErrUserNotFound),
+		errors.Is(err, ErrConfigVersionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrApplicationAlreadyRunning),
+		errors.Is(err, ErrApplicationNotRunning),
+		errors.Is(err, ErrApplicationIdentifierExists),
+		errors.Is(err, ErrDeadLettersDisabled),
+		errors.Is(err, ErrDeadLetterReplayInProgress):
+		return http.StatusConflict
+	case errors.Is(err, ErrApplicationMissingIdentifier),
+		errors.Is(err, ErrApplicationMissingBotToken),
+		errors.Is(err, ErrApplicationInvalidShardIDs),
+		errors.As(err, &configurationErrors):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrGatewayBotRateLimited):
+		return http.StatusTooManyRequests
+	case errors.Is(err, ErrGatewayBotInvalidResponse):
+		return http.StatusBadGateway
+	case errors.Is(err, ErrConfigVersioningUnsupported):
+		return http.StatusNotImplemented
+	}
+
+	if grpcStatus, ok := status.FromError(err); ok {
+		return httpStatusFromCode(grpcStatus.Code())
+	}
+
+	return http.StatusInternalServerError
+}
+
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Canceled, codes.Unknown, codes.Internal, codes.DataLoss:
+		return http.StatusInternalServerError
+	}
+
+	return http.StatusInternalServerError
+}
+
+func writeHTTPAPIMessage(w http.ResponseWriter, statusCode int, message proto.Message) {
+	data, err := protojson.MarshalOptions{EmitUnpopulated: true, UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		writeHTTPAPIError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	_, _ = w.Write(data)
+}
+
+// writeHTTPAPIError writes an error in the same shape as a BaseResponse.
+func writeHTTPAPIError(w http.ResponseWriter, statusCode int, err error) {
+	message := err.Error()
+	if grpcStatus, ok := status.FromError(err); ok {
+		message = grpcStatus.Message()
+	}
+
+	writeHTTPAPIMessage(w, statusCode, &pb.BaseResponse{
+		Ok:    false,
+		Error: message,
+	})
+}
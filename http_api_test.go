@@ -0,0 +1,155 @@
+package sandwich_test
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPAPI(t *testing.T) {
+	t.Parallel()
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil)
+	sandwichInstance.Applications.Store("welcomer", sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{ApplicationIdentifier: "welcomer"}))
+
+	api := sandwichInstance.NewHTTPAPI()
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		body        string
+		status      int
+	}{
+		{"unknown method", http.MethodPost, "/api/v1/Unknown", "application/json", "{}", http.StatusNotFound},
+		{"read only get", http.MethodGet, "/api/v1/FetchApplication?application_identifier=welcomer", "", "", http.StatusOK},
+		{"mutating get", http.MethodGet, "/api/v1/StopApplication?application_identifier=welcomer", "", "", http.StatusMethodNotAllowed},
+		{"unsupported method", http.MethodPut, "/api/v1/FetchApplication", "application/json", "{}", http.StatusMethodNotAllowed},
+		{"missing content type", http.MethodPost, "/api/v1/FetchApplication", "", "{}", http.StatusUnsupportedMediaType},
+		{"form content type", http.MethodPost, "/api/v1/FetchApplication", "application/x-www-form-urlencoded", "{}", http.StatusUnsupportedMediaType},
+		{"content type parameters", http.MethodPost, "/api/v1/FetchApplication", "application/json; charset=utf-8", "{}", http.StatusOK},
+		{"empty body", http.MethodPost, "/api/v1/FetchApplication", "application/json", "", http.StatusOK},
+		{"invalid body", http.MethodPost, "/api/v1/FetchApplication", "application/json", "{", http.StatusBadRequest},
+		{"application not found", http.MethodPost, "/api/v1/StopApplication", "application/json", `{"application_identifier":"other"}`, http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			if test.contentType != "" {
+				r.Header.Set("Content-Type", test.contentType)
+			}
+
+			w := httptest.NewRecorder()
+			api.ServeHTTP(w, r)
+
+			assert.Equal(t, test.status, w.Code, w.Body.String())
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+			var response map[string]any
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+			baseResponse, ok := response["base_response"].(map[string]any)
+			if !ok {
+				baseResponse = response
+			}
+
+			assert.Equal(t, test.status == http.StatusOK, baseResponse["ok"])
+		})
+	}
+}
+
+func TestHTTPAPIFetchApplication(t *testing.T) {
+	t.Parallel()
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil)
+	sandwichInstance.Applications.Store("welcomer", sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{ApplicationIdentifier: "welcomer"}))
+	sandwichInstance.Applications.Store("other", sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{ApplicationIdentifier: "other"}))
+
+	w := httptest.NewRecorder()
+	sandwichInstance.NewHTTPAPI().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/FetchApplication?application_identifier=welcomer", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Applications map[string]any `json:"applications"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	assert.Len(t, response.Applications, 1)
+	assert.Contains(t, response.Applications, "welcomer")
+}
+
+func TestHTTPAPIAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil)
+	sandwichInstance.Applications.Store("welcomer", sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{ApplicationIdentifier: "welcomer"}))
+
+	// The API is created before the authenticator is set, which must still protect it.
+	api := sandwichInstance.NewHTTPAPI()
+
+	authenticator, err := sandwich.NewAuthenticator(slog.Default(), sandwich.AuthPolicy{
+		Principals: []*sandwich.Principal{
+			{
+				Name:         "welcomer",
+				BearerTokens: []string{"welcomer-token"},
+				Applications: []string{"welcomer"},
+				Permissions:  []sandwich.Permission{sandwich.PermissionControlApplications},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	sandwichInstance.WithAuthenticator(authenticator)
+
+	call := func(token, applicationIdentifier string) int {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/StopApplication", strings.NewReader(`{"application_identifier":"`+applicationIdentifier+`"}`))
+		r.Header.Set("Content-Type", "application/json")
+
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		w := httptest.NewRecorder()
+		api.ServeHTTP(w, r)
+
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, call("", "welcomer"))
+	assert.Equal(t, http.StatusUnauthorized, call("invalid", "welcomer"))
+	assert.Equal(t, http.StatusForbidden, call("welcomer-token", "other"))
+	assert.Equal(t, http.StatusOK, call("welcomer-token", "welcomer"))
+}
+
+func TestHTTPAPISharesPrometheusServer(t *testing.T) {
+	t.Parallel()
+
+	server := &http.Server{Addr: "127.0.0.1:0", ReadHeaderTimeout: time.Second}
+
+	// Either order serves both on the same server, which is only started once.
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil).
+		WithHTTPAPI(server).
+		WithPrometheusAnalytics(server, nil, promhttp.HandlerOpts{})
+
+	for _, path := range []string{"/metrics", "/api/v1/FetchApplication"} {
+		w := httptest.NewRecorder()
+		server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, path)
+	}
+
+	assert.NotNil(t, sandwichInstance)
+}
@@ -2,6 +2,8 @@ package sandwich
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 )
 
@@ -14,3 +16,17 @@ var (
 type IdentifyProvider interface {
 	Identify(ctx context.Context, shard *Shard) error
 }
+
+// IdentifyTokenRotator is implemented by identify providers that key their state by the bot token.
+// It is called when the token of an application is rotated so the state can be moved to the new token,
+// as identify limits belong to the application and not the token.
+type IdentifyTokenRotator interface {
+	RotateToken(ctx context.Context, oldToken, newToken string) error
+}
+
+// identifyTokenHash returns the hash of a bot token that is used to key identify state.
+func identifyTokenHash(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -2,8 +2,6 @@ package sandwich
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 
 	"github.com/WelcomerTeam/Sandwich-Daemon/pkg/bucketstore"
@@ -22,9 +20,7 @@ func NewIdentifyViaBuckets() *IdentifyViaBuckets {
 }
 
 func (i *IdentifyViaBuckets) Identify(_ context.Context, shard *Shard) error {
-	method := sha256.New()
-	method.Write([]byte(shard.Application.Configuration.Load().BotToken))
-	tokenHash := hex.EncodeToString(method.Sum(nil))
+	tokenHash := identifyTokenHash(shard.Application.BotToken())
 
 	bucketName := fmt.Sprintf(
 		"identify:%s:%d",
@@ -42,3 +38,14 @@ func (i *IdentifyViaBuckets) Identify(_ context.Context, shard *Shard) error {
 
 	return nil
 }
+
+// RotateToken moves the identify buckets of the old token to the new token, so identifies
+// that were already made still count towards the limit.
+func (i *IdentifyViaBuckets) RotateToken(_ context.Context, oldToken, newToken string) error {
+	i.bucketStore.RenameBuckets(
+		"identify:"+identifyTokenHash(oldToken)+":",
+		"identify:"+identifyTokenHash(newToken)+":",
+	)
+
+	return nil
+}
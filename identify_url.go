@@ -3,8 +3,6 @@ package sandwich
 import (
 	"bytes"
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -40,14 +38,12 @@ func NewIdentifyViaURL(url string, headers map[string]string) *IdentifyViaURL {
 }
 
 func (i *IdentifyViaURL) Identify(ctx context.Context, shard *Shard) error {
-	method := sha256.New()
-	method.Write([]byte(shard.Application.Configuration.Load().BotToken))
-	tokenHash := hex.EncodeToString(method.Sum(nil))
+	tokenHash := identifyTokenHash(shard.Application.BotToken())
 
 	identifyURL := i.URL
 	identifyURL = strings.Replace(identifyURL, "{shard_id}", strconv.Itoa(int(shard.ShardID)), 1)
 	identifyURL = strings.Replace(identifyURL, "{shard_count}", strconv.Itoa(int(shard.Application.ShardCount.Load())), 1)
-	identifyURL = strings.Replace(identifyURL, "{token}", shard.Application.Configuration.Load().BotToken, 1)
+	identifyURL = strings.Replace(identifyURL, "{token}", shard.Application.BotToken(), 1)
 	identifyURL = strings.Replace(identifyURL, "{token_hash}", tokenHash, 1)
 	identifyURL = strings.Replace(identifyURL, "{max_concurrency}", strconv.Itoa(int(shard.Application.Gateway.Load().SessionStartLimit.MaxConcurrency)), 1)
 
@@ -66,7 +62,7 @@ func (i *IdentifyViaURL) Identify(ctx context.Context, shard *Shard) error {
 		ShardID:        int(shard.ShardID),
 		ShardCount:     int(shard.Application.Configuration.Load().ShardCount),
 		MaxConcurrency: int(shard.Application.Gateway.Load().SessionStartLimit.MaxConcurrency),
-		Token:          shard.Application.Configuration.Load().BotToken,
+		Token:          shard.Application.BotToken(),
 		TokenHash:      tokenHash,
 	}
 
@@ -0,0 +1,177 @@
+package sandwich
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultListenerBufferSize is the number of broadcasts that can be buffered for a listener
+// before the overflow policy is applied.
+var DefaultListenerBufferSize = 256
+
+// ListenerOverflowPolicy decides what happens when a listener's buffer is full.
+type ListenerOverflowPolicy int32
+
+const (
+	// ListenerOverflowDropOldest discards the oldest buffered message to make room for the new one.
+	ListenerOverflowDropOldest ListenerOverflowPolicy = iota
+	// ListenerOverflowDropNewest discards the message that is being broadcast.
+	ListenerOverflowDropNewest
+	// ListenerOverflowDisconnect discards the message and disconnects the listener.
+	ListenerOverflowDisconnect
+)
+
+func (policy ListenerOverflowPolicy) String() string {
+	switch policy {
+	case ListenerOverflowDropOldest:
+		return "DropOldest"
+	case ListenerOverflowDropNewest:
+		return "DropNewest"
+	case ListenerOverflowDisconnect:
+		return "Disconnect"
+	default:
+		return fmt.Sprintf("ListenerOverflowPolicy(%d)", int32(policy))
+	}
+}
+
+// Valid returns true if the policy is one of the known policies.
+func (policy ListenerOverflowPolicy) Valid() bool {
+	return policy >= ListenerOverflowDropOldest && policy <= ListenerOverflowDisconnect
+}
+
+var listenerOverflowPolicyNames = map[string]ListenerOverflowPolicy{
+	"drop_oldest": ListenerOverflowDropOldest,
+	"drop_newest": ListenerOverflowDropNewest,
+	"disconnect":  ListenerOverflowDisconnect,
+}
+
+// UnmarshalText parses drop_oldest, drop_newest or disconnect, so policies can be configured.
+func (policy *ListenerOverflowPolicy) UnmarshalText(text []byte) error {
+	parsed, ok := listenerOverflowPolicyNames[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown overflow policy %q, expected drop_oldest, drop_newest or disconnect", text)
+	}
+
+	*policy = parsed
+
+	return nil
+}
+
+func (policy ListenerOverflowPolicy) MarshalText() ([]byte, error) {
+	for name, value := range listenerOverflowPolicyNames {
+		if value == policy {
+			return []byte(name), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown overflow policy %d", policy)
+}
+
+type listenerData struct {
+	timestamp time.Time
+	sequence  int64
+	payload   []byte
+}
+
+// Listener is a subscriber to broadcasts. Each listener has its own bounded buffer so
+// a slow listener cannot block the broadcaster.
+type Listener struct {
+	channel chan *listenerData
+	policy  ListenerOverflowPolicy
+
+	dropped *atomic.Int64
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func NewListener(bufferSize int, policy ListenerOverflowPolicy) *Listener {
+	if bufferSize <= 0 {
+		bufferSize = DefaultListenerBufferSize
+	}
+
+	if !policy.Valid() {
+		policy = ListenerOverflowDropOldest
+	}
+
+	return &Listener{
+		channel: make(chan *listenerData, bufferSize),
+		policy:  policy,
+
+		dropped: &atomic.Int64{},
+
+		closed:    make(chan struct{}),
+		closeOnce: sync.Once{},
+	}
+}
+
+// Dropped returns the number of messages that have been dropped for this listener.
+func (listener *Listener) Dropped() int64 {
+	return listener.dropped.Load()
+}
+
+// Done is closed when the listener has been disconnected due to overflowing.
+func (listener *Listener) Done() <-chan struct{} {
+	return listener.closed
+}
+
+// push enqueues a message without blocking, applying the overflow policy if the buffer is full.
+func (listener *Listener) push(data *listenerData) {
+	select {
+	case <-listener.closed:
+		return
+	default:
+	}
+
+	switch listener.policy {
+	case ListenerOverflowDropNewest:
+		select {
+		case listener.channel <- data:
+		default:
+			listener.drop()
+		}
+	case ListenerOverflowDisconnect:
+		select {
+		case listener.channel <- data:
+		default:
+			listener.drop()
+			listener.closeOnce.Do(func() {
+				close(listener.closed)
+			})
+		}
+	case ListenerOverflowDropOldest:
+		for {
+			select {
+			case listener.channel <- data:
+				return
+			default:
+			}
+
+			// Buffer is full, discard the oldest message and try again.
+			select {
+			case <-listener.channel:
+				listener.drop()
+			default:
+			}
+		}
+	}
+}
+
+func (listener *Listener) drop() {
+	listener.dropped.Add(1)
+	RecordListenerDrop(listener.policy)
+}
+
+func (sandwich *Sandwich) AddListener(listener *Listener) int32 {
+	counter := sandwich.listenerCounter.Add(1)
+
+	sandwich.listeners.Store(counter, listener)
+
+	return counter
+}
+
+func (sandwich *Sandwich) RemoveListener(counter int32) {
+	sandwich.listeners.Delete(counter)
+}
@@ -0,0 +1,73 @@
+package sandwich_test
+
+import (
+	"log/slog"
+	"testing"
+
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListenerOverflowPolicies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		policy       sandwich.ListenerOverflowPolicy
+		name         string
+		disconnected bool
+	}{
+		{policy: sandwich.ListenerOverflowDropOldest, name: "DropOldest"},
+		{policy: sandwich.ListenerOverflowDropNewest, name: "DropNewest"},
+		{policy: sandwich.ListenerOverflowDisconnect, name: "Disconnect", disconnected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.name, test.policy.String())
+
+			sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil)
+
+			listener := sandwich.NewListener(2, test.policy)
+			sandwichInstance.AddListener(listener)
+
+			// The buffer fits two broadcasts, so the third overflows.
+			for range 3 {
+				assert.NoError(t, sandwichInstance.Broadcast("TEST", nil))
+			}
+
+			assert.Equal(t, int64(1), listener.Dropped())
+
+			select {
+			case <-listener.Done():
+				assert.True(t, test.disconnected)
+			default:
+				assert.False(t, test.disconnected)
+			}
+		})
+	}
+}
+
+func TestListenerOverflowPolicyUnknown(t *testing.T) {
+	t.Parallel()
+
+	policy := sandwich.ListenerOverflowPolicy(7)
+
+	assert.False(t, policy.Valid())
+	assert.Equal(t, "ListenerOverflowPolicy(7)", policy.String())
+
+	// Unknown policies fall back to dropping the oldest message rather than discarding everything.
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil)
+
+	listener := sandwich.NewListener(1, policy)
+	sandwichInstance.AddListener(listener)
+
+	assert.NoError(t, sandwichInstance.Broadcast("TEST", nil))
+	assert.NoError(t, sandwichInstance.Broadcast("TEST", nil))
+	assert.Equal(t, int64(1), listener.Dropped())
+
+	assert.Error(t, policy.UnmarshalText([]byte("drop_everything")))
+	assert.NoError(t, policy.UnmarshalText([]byte("drop_newest")))
+	assert.Equal(t, sandwich.ListenerOverflowDropNewest, policy)
+}
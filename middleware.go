@@ -0,0 +1,105 @@
+package sandwich
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WelcomerTeam/Discord/discord"
+)
+
+// EventMiddleware wraps the dispatch of events by EventProviderWithBlacklist. Each hook returns
+// false to drop the event, and can change the values it is passed.
+type EventMiddleware interface {
+	// BeforeDispatch is called before the event is handled. Dropping the event here also skips state updates.
+	BeforeDispatch(ctx context.Context, shard *Shard, event *discord.GatewayPayload, trace *Trace) (ok bool, err error)
+	// AfterDispatch is called after the event is handled and before the produce blacklist, guild lists and event rules are checked.
+	AfterDispatch(ctx context.Context, shard *Shard, event *discord.GatewayPayload, result *DispatchResult, trace *Trace) (ok bool, err error)
+	// BeforePublish is called before the event is published to the producer.
+	BeforePublish(ctx context.Context, shard *Shard, payload *ProducedPayload) (ok bool, err error)
+}
+
+// EventMiddlewareFuncs is an EventMiddleware made from functions. Hooks that are nil are skipped.
+type EventMiddlewareFuncs struct {
+	BeforeDispatchFunc func(ctx context.Context, shard *Shard, event *discord.GatewayPayload, trace *Trace) (bool, error)
+	AfterDispatchFunc  func(ctx context.Context, shard *Shard, event *discord.GatewayPayload, result *DispatchResult, trace *Trace) (bool, error)
+	BeforePublishFunc  func(ctx context.Context, shard *Shard, payload *ProducedPayload) (bool, error)
+}
+
+func (middleware EventMiddlewareFuncs) BeforeDispatch(ctx context.Context, shard *Shard, event *discord.GatewayPayload, trace *Trace) (bool, error) {
+	if middleware.BeforeDispatchFunc == nil {
+		return true, nil
+	}
+
+	return middleware.BeforeDispatchFunc(ctx, shard, event, trace)
+}
+
+func (middleware EventMiddlewareFuncs) AfterDispatch(ctx context.Context, shard *Shard, event *discord.GatewayPayload, result *DispatchResult, trace *Trace) (bool, error) {
+	if middleware.AfterDispatchFunc == nil {
+		return true, nil
+	}
+
+	return middleware.AfterDispatchFunc(ctx, shard, event, result, trace)
+}
+
+func (middleware EventMiddlewareFuncs) BeforePublish(ctx context.Context, shard *Shard, payload *ProducedPayload) (bool, error) {
+	if middleware.BeforePublishFunc == nil {
+		return true, nil
+	}
+
+	return middleware.BeforePublishFunc(ctx, shard, payload)
+}
+
+// WithEventMiddleware adds middlewares that are called for every event, in the order they are added.
+func (sandwich *Sandwich) WithEventMiddleware(middlewares ...EventMiddleware) *Sandwich {
+	sandwich.eventMiddlewares = append(sandwich.eventMiddlewares, middlewares...)
+
+	return sandwich
+}
+
+// beforeDispatch calls the BeforeDispatch hook of every middleware. Returns false if the event was dropped.
+func (sandwich *Sandwich) beforeDispatch(ctx context.Context, shard *Shard, event *discord.GatewayPayload, trace *Trace) (bool, error) {
+	for _, middleware := range sandwich.eventMiddlewares {
+		ok, err := middleware.BeforeDispatch(ctx, shard, event, trace)
+		if err != nil {
+			return false, fmt.Errorf("middleware %T failed before dispatch: %w", middleware, err)
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// afterDispatch calls the AfterDispatch hook of every middleware. Returns false if the event was dropped.
+func (sandwich *Sandwich) afterDispatch(ctx context.Context, shard *Shard, event *discord.GatewayPayload, result *DispatchResult, trace *Trace) (bool, error) {
+	for _, middleware := range sandwich.eventMiddlewares {
+		ok, err := middleware.AfterDispatch(ctx, shard, event, result, trace)
+		if err != nil {
+			return false, fmt.Errorf("middleware %T failed after dispatch: %w", middleware, err)
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// beforePublish calls the BeforePublish hook of every middleware. Returns false if the event was dropped.
+func (sandwich *Sandwich) beforePublish(ctx context.Context, shard *Shard, payload *ProducedPayload) (bool, error) {
+	for _, middleware := range sandwich.eventMiddlewares {
+		ok, err := middleware.BeforePublish(ctx, shard, payload)
+		if err != nil {
+			return false, fmt.Errorf("middleware %T failed before publish: %w", middleware, err)
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -2,6 +2,7 @@ package bucketstore
 
 import (
 	"errors"
+	"strings"
 	"sync"
 	"time"
 
@@ -94,3 +95,33 @@ func (bs *BucketStore) CreateWaitForBucket(name string, limit int32, duration ti
 
 	return
 }
+
+// UpdateBucket will update the limit, available slots and reset of a bucket, creating it if it does not exist.
+func (bs *BucketStore) UpdateBucket(name string, limit, available int32, resetAfter time.Duration) {
+	bucket := bs.CreateBucket(name, limit, resetAfter)
+	bucket.Update(limit, available, resetAfter)
+}
+
+// RenameBuckets will rename every bucket starting with oldPrefix to start with newPrefix,
+// keeping their limiters. Existing buckets with the new name are overwritten.
+// Returns the number of buckets renamed.
+func (bs *BucketStore) RenameBuckets(oldPrefix, newPrefix string) int {
+	bs.BucketsMu.Lock()
+	defer bs.BucketsMu.Unlock()
+
+	// Buckets are collected first, as buckets added while ranging may be visited.
+	buckets := make(map[string]*limiter.DurationLimiter)
+
+	for name, bucket := range bs.Buckets {
+		if strings.HasPrefix(name, oldPrefix) {
+			buckets[name] = bucket
+		}
+	}
+
+	for name, bucket := range buckets {
+		delete(bs.Buckets, name)
+		bs.Buckets[newPrefix+strings.TrimPrefix(name, oldPrefix)] = bucket
+	}
+
+	return len(buckets)
+}
@@ -102,3 +102,11 @@ func (l *DurationLimiter) Reset() {
 	now := time.Now().UnixNano()
 	atomic.StoreInt64(l.resetsAt, now+atomic.LoadInt64(l.duration))
 }
+
+// Update sets the limit, the number of available slots and when the limiter next resets.
+// This is useful when the limit is reported by the remote, such as with rate limit headers.
+func (l *DurationLimiter) Update(limit, available int32, resetAfter time.Duration) {
+	atomic.StoreInt32(l.limit, limit)
+	atomic.StoreInt32(l.available, available)
+	atomic.StoreInt64(l.resetsAt, time.Now().Add(resetAfter).UnixNano())
+}
@@ -48,6 +48,13 @@ func (m *Map[K, V]) Delete(key K) {
 	m.mu.Unlock()
 }
 
+// Clear deletes every key-value pair in the map
+func (m *Map[K, V]) Clear() {
+	m.mu.Lock()
+	clear(m.m)
+	m.mu.Unlock()
+}
+
 // LoadAndDelete loads and deletes the value for the key
 func (m *Map[K, V]) LoadAndDelete(key K) (V, bool) {
 	m.mu.Lock()
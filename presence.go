@@ -0,0 +1,131 @@
+package sandwich
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/WelcomerTeam/Discord/discord"
+)
+
+// MinimumPresenceRotationInterval is the shortest interval presences can be rotated at.
+// Discord limits how often a shard can update its presence.
+const MinimumPresenceRotationInterval = time.Second * 15
+
+// PresenceRotationConfiguration cycles the presence of every shard through a list of presences.
+//
+// Activity names, states, details and URLs can contain template variables:
+//   - {guild_count}: guilds on the shard
+//   - {total_guild_count}: guilds on the application
+//   - {shard_id}: the shard ID
+//   - {shard_count}: the number of shards in the application
+//   - {application_identifier}: the application identifier
+type PresenceRotationConfiguration struct {
+	Presences       []discord.UpdateStatus `json:"presences"`
+	IntervalSeconds int32                  `json:"interval_seconds"`
+}
+
+// Enabled returns true if there are presences to rotate through.
+func (rotation *PresenceRotationConfiguration) Enabled() bool {
+	return len(rotation.Presences) > 0
+}
+
+// Interval returns the rotation interval.
+func (rotation *PresenceRotationConfiguration) Interval() time.Duration {
+	return time.Duration(rotation.IntervalSeconds) * time.Second
+}
+
+// startPresenceRotation starts rotating presences if the application has presences configured.
+// A rotation that is already running is stopped first.
+func (application *Application) startPresenceRotation(ctx context.Context) {
+	application.stopPresenceRotation()
+
+	rotation := application.Configuration.Load().PresenceRotation
+	if !rotation.Enabled() {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	application.presenceRotationCancel.Store(&cancel)
+
+	go application.rotatePresences(ctx, max(rotation.Interval(), MinimumPresenceRotationInterval))
+}
+
+// stopPresenceRotation stops rotating presences, if it is running.
+func (application *Application) stopPresenceRotation() {
+	if cancel := application.presenceRotationCancel.Swap(nil); cancel != nil {
+		(*cancel)()
+	}
+}
+
+func (application *Application) rotatePresences(ctx context.Context, interval time.Duration) {
+	application.Logger.Debug("Rotating presences", "interval", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		index := application.presenceRotationIndex.Add(1)
+
+		application.Shards.Range(func(_ int32, shard *Shard) bool {
+			if ShardStatus(shard.Status.Load()) != ShardStatusReady {
+				return true
+			}
+
+			presence := shard.presence()
+
+			// Sent through the shard so the gateway rate limit is respected.
+			if err := shard.SendEvent(ctx, discord.GatewayOpStatusUpdate, presence); err != nil {
+				shard.Logger.Warn("Failed to update presence", "error", err, "index", index)
+			}
+
+			return ctx.Err() == nil
+		})
+	}
+}
+
+// presence returns the presence the shard should have. This is the current rotated presence
+// if presence rotation is configured, otherwise the default presence.
+func (shard *Shard) presence() discord.UpdateStatus {
+	configuration := shard.Application.Configuration.Load()
+
+	rotation := configuration.PresenceRotation
+	if !rotation.Enabled() {
+		return configuration.DefaultPresence
+	}
+
+	index := shard.Application.presenceRotationIndex.Load() % int64(len(rotation.Presences))
+
+	return renderPresence(rotation.Presences[index], strings.NewReplacer(
+		"{guild_count}", strconv.Itoa(shard.Guilds.Count()),
+		"{total_guild_count}", strconv.Itoa(shard.Application.guilds.Count()),
+		"{shard_id}", strconv.Itoa(int(shard.ShardID)),
+		"{shard_count}", strconv.Itoa(int(shard.Application.ShardCount.Load())),
+		"{application_identifier}", shard.Application.Identifier,
+	))
+}
+
+// renderPresence fills in the template variables of a presence.
+func renderPresence(presence discord.UpdateStatus, replacer *strings.Replacer) discord.UpdateStatus {
+	activities := make(discord.ActivityList, 0, len(presence.Activities))
+
+	for _, activity := range presence.Activities {
+		activity.Name = replacer.Replace(activity.Name)
+		activity.State = replacer.Replace(activity.State)
+		activity.Details = replacer.Replace(activity.Details)
+		activity.URL = replacer.Replace(activity.URL)
+
+		activities = append(activities, activity)
+	}
+
+	presence.Activities = activities
+
+	return presence
+}
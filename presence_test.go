@@ -0,0 +1,111 @@
+package sandwich_test
+
+import (
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/WelcomerTeam/Discord/discord"
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderPresence(t *testing.T) {
+	t.Parallel()
+
+	presence := discord.UpdateStatus{
+		Status: "online",
+		Activities: discord.ActivityList{
+			{
+				Name:    "{guild_count} guilds",
+				State:   "shard {shard_id}",
+				Details: "{unknown}",
+				URL:     "https://example.com/{shard_id}",
+				Type:    discord.ActivityTypeListening,
+			},
+		},
+	}
+
+	rendered := sandwich.RenderPresence(presence, strings.NewReplacer("{guild_count}", "10", "{shard_id}", "2"))
+
+	assert.Equal(t, "online", rendered.Status)
+	assert.Len(t, rendered.Activities, 1)
+	assert.Equal(t, "10 guilds", rendered.Activities[0].Name)
+	assert.Equal(t, "shard 2", rendered.Activities[0].State)
+	assert.Equal(t, "{unknown}", rendered.Activities[0].Details)
+	assert.Equal(t, "https://example.com/2", rendered.Activities[0].URL)
+	assert.Equal(t, discord.ActivityTypeListening, rendered.Activities[0].Type)
+
+	// The configured presence is left as a template.
+	assert.Equal(t, "{guild_count} guilds", presence.Activities[0].Name)
+}
+
+func TestShardPresence(t *testing.T) {
+	t.Parallel()
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil)
+
+	defaultPresence := discord.UpdateStatus{Status: "idle"}
+
+	application := sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{
+		ApplicationIdentifier: "welcomer",
+		DefaultPresence:       defaultPresence,
+	})
+	application.ShardCount.Store(2)
+
+	shard := sandwich.NewShard(sandwichInstance, application, 1)
+
+	// Without presence rotation, the default presence is used.
+	assert.Equal(t, defaultPresence, shard.Presence())
+
+	application.UpdateConfiguration(&sandwich.ApplicationConfiguration{
+		ApplicationIdentifier: "welcomer",
+		DefaultPresence:       defaultPresence,
+		PresenceRotation: sandwich.PresenceRotationConfiguration{
+			Presences: []discord.UpdateStatus{
+				{Status: "online", Activities: discord.ActivityList{{Name: "{application_identifier} {shard_id}/{shard_count}"}}},
+			},
+			IntervalSeconds: 60,
+		},
+	})
+
+	presence := shard.Presence()
+	assert.Equal(t, "online", presence.Status)
+	assert.Equal(t, "welcomer 1/2", presence.Activities[0].Name)
+}
+
+func TestUpdateConfigurationPresenceRotation(t *testing.T) {
+	t.Parallel()
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil)
+
+	withoutRotation := &sandwich.ApplicationConfiguration{ApplicationIdentifier: "welcomer"}
+
+	withRotation := func(name string) *sandwich.ApplicationConfiguration {
+		return &sandwich.ApplicationConfiguration{
+			ApplicationIdentifier: "welcomer",
+			PresenceRotation: sandwich.PresenceRotationConfiguration{
+				Presences:       []discord.UpdateStatus{{Activities: discord.ActivityList{{Name: name}}}},
+				IntervalSeconds: 60,
+			},
+		}
+	}
+
+	application := sandwich.NewApplication(sandwichInstance, withoutRotation)
+
+	// Rotation is only started once the application is ready.
+	application.UpdateConfiguration(withRotation("first"))
+	assert.False(t, application.PresenceRotationRunning())
+
+	application.SetStatus(sandwich.ApplicationStatusReady)
+
+	application.UpdateConfiguration(withRotation("second"))
+	assert.True(t, application.PresenceRotationRunning())
+
+	// Unrelated changes leave the rotation running.
+	application.UpdateConfiguration(withRotation("second"))
+	assert.True(t, application.PresenceRotationRunning())
+
+	application.UpdateConfiguration(withoutRotation)
+	assert.False(t, application.PresenceRotationRunning())
+}
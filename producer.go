@@ -1,6 +1,10 @@
 package sandwich
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
 
 type ProducerProvider interface {
 	GetProducer(ctx context.Context, applicationIdentifier, clientName string) (Producer, error)
@@ -10,3 +14,49 @@ type Producer interface {
 	Publish(ctx context.Context, shard *Shard, payload *ProducedPayload) error
 	Close() error
 }
+
+// DefaultProducerName is the name of the producer from the ProducerProvider, which events that match no
+// producer route are published to. Producer routes can also publish to it by this name.
+const DefaultProducerName = "default"
+
+// ProducerConfiguration is a named producer that producer routes can publish events to.
+type ProducerConfiguration struct {
+	Name string `json:"name"`
+	// Type is the type of producer, registered with WithProducerType. When empty, the producer is
+	// created by the ProducerProvider.
+	Type string `json:"type"`
+	// Identifier is passed to the ProducerProvider instead of the application identifier, such as
+	// the name of a stream. Only used when Type is empty.
+	Identifier string `json:"identifier"`
+	// Options are passed to the ProducerFactory of the type.
+	Options json.RawMessage `json:"options,omitempty"`
+}
+
+// ProducerFactory creates a producer of a type for an application.
+type ProducerFactory func(ctx context.Context, application *Application, configuration ProducerConfiguration) (Producer, error)
+
+// WithProducerType registers a type of producer that named producers in the configuration can use.
+func (sandwich *Sandwich) WithProducerType(producerType string, factory ProducerFactory) *Sandwich {
+	sandwich.producerTypes[producerType] = factory
+
+	return sandwich
+}
+
+// newNamedProducer creates a named producer from its configuration.
+func (application *Application) newNamedProducer(ctx context.Context, configuration ProducerConfiguration, clientName string) (Producer, error) {
+	if configuration.Type == "" {
+		identifier := configuration.Identifier
+		if identifier == "" {
+			identifier = application.Identifier
+		}
+
+		return application.Sandwich.producerProvider.GetProducer(ctx, identifier, clientName)
+	}
+
+	factory, ok := application.Sandwich.producerTypes[configuration.Type]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrProducerTypeUnknown, configuration.Type)
+	}
+
+	return factory(ctx, application, configuration)
+}
@@ -0,0 +1,229 @@
+package sandwich
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RetryPolicy decides how publishing an event is retried.
+type RetryPolicy struct {
+	// MaxAttempts is how many times an event is published before it fails, including the first attempt.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry, which is multiplied by Multiplier for every retry after.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	// Retryable returns true if publishing can be retried after an error. When nil, IsRetryableProducerError is used.
+	Retryable func(err error) bool
+}
+
+// DefaultRetryPolicy is used for the fields of a ProducerRetryConfiguration that are not set.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond * 100,
+	MaxBackoff:     time.Second * 5,
+	Multiplier:     2,
+}
+
+// ProducerRetryConfiguration retries events that a producer fails to publish and writes events
+// that still fail to a dead letter file, so they can be replayed with ReplayDeadLetters.
+type ProducerRetryConfiguration struct {
+	// MaxAttempts is how many times an event is published before it fails. Retrying is disabled when 0.
+	MaxAttempts                int32 `json:"max_attempts"`
+	InitialBackoffMilliseconds int32 `json:"initial_backoff_milliseconds"`
+	MaxBackoffMilliseconds     int32 `json:"max_backoff_milliseconds"`
+
+	// DeadLetterPath is the file that events are written to once every attempt has failed.
+	// When empty, the error is returned and the event is not kept.
+	DeadLetterPath string `json:"dead_letter_path"`
+	// DeadLetterMaxBytes is the largest the dead letter file can be. There is no limit when 0.
+	DeadLetterMaxBytes int64 `json:"dead_letter_max_bytes"`
+}
+
+// Enabled returns true if events are retried.
+func (config ProducerRetryConfiguration) Enabled() bool {
+	return config.MaxAttempts > 0
+}
+
+// Policy returns the retry policy, using DefaultRetryPolicy for fields that are not set.
+func (config ProducerRetryConfiguration) Policy() RetryPolicy {
+	policy := DefaultRetryPolicy
+	policy.MaxAttempts = int(config.MaxAttempts)
+
+	if config.InitialBackoffMilliseconds > 0 {
+		policy.InitialBackoff = time.Duration(config.InitialBackoffMilliseconds) * time.Millisecond
+	}
+
+	if config.MaxBackoffMilliseconds > 0 {
+		policy.MaxBackoff = time.Duration(config.MaxBackoffMilliseconds) * time.Millisecond
+	}
+
+	return policy
+}
+
+// backoff returns the wait before a retry. Attempt is the attempt that failed, starting at 1.
+func (policy RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := float64(policy.InitialBackoff)
+
+	for range attempt - 1 {
+		backoff *= policy.Multiplier
+
+		if policy.MaxBackoff > 0 && backoff >= float64(policy.MaxBackoff) {
+			return policy.MaxBackoff
+		}
+	}
+
+	return time.Duration(backoff)
+}
+
+func (policy RetryPolicy) retryable(err error) bool {
+	if policy.Retryable != nil {
+		return policy.Retryable(err)
+	}
+
+	return IsRetryableProducerError(err)
+}
+
+// IsRetryableProducerError returns false for errors that publishing again will not fix, which are
+// context errors and errors that wrap ErrProducerPermanent.
+func IsRetryableProducerError(err error) bool {
+	return !errors.Is(err, ErrProducerPermanent) &&
+		!errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded)
+}
+
+// BackgroundFailureReporter is implemented by producers that publish some events in the background, such as
+// batching webhooks, so failures cannot be returned from Publish.
+type BackgroundFailureReporter interface {
+	// OnBackgroundFailure sets the function called with the events that failed to publish. The error it returns is logged.
+	OnBackgroundFailure(fn func(payloads []ProducedPayload, err error) error)
+}
+
+// RetryingProducer is a producer that retries events that fail to publish. Events that fail every
+// attempt are written to a dead letter spool, if there is one.
+type RetryingProducer struct {
+	identifier string
+	name       string
+
+	producer    Producer
+	policy      RetryPolicy
+	deadLetters *DeadLetterSpool
+}
+
+// NewRetryingProducer wraps a producer so publishing is retried. Identifier and name are the application identifier
+// and producer name used in metrics and dead letters. deadLetters can be nil, in which case the error is returned.
+// Events that a BackgroundFailureReporter fails to publish in the background are written to the dead letter spool.
+func NewRetryingProducer(identifier, name string, producer Producer, policy RetryPolicy, deadLetters *DeadLetterSpool) *RetryingProducer {
+	p := &RetryingProducer{
+		identifier: identifier,
+		name:       name,
+
+		producer:    producer,
+		policy:      policy,
+		deadLetters: deadLetters,
+	}
+
+	if reporter, ok := producer.(BackgroundFailureReporter); ok {
+		reporter.OnBackgroundFailure(p.backgroundFailure)
+	}
+
+	return p
+}
+
+// Publish publishes the event, retrying with the retry policy. When every attempt fails, the event is
+// written to the dead letter spool.
+func (p *RetryingProducer) Publish(ctx context.Context, shard *Shard, payload *ProducedPayload) error {
+	attempts, err := p.publish(ctx, shard, payload)
+	if err == nil {
+		return nil
+	}
+
+	return p.fail(payload, attempts, err)
+}
+
+// backgroundFailure writes events that the producer failed to publish in the background to the dead letter spool.
+// They have already been retried by the producer, so they are not retried again.
+func (p *RetryingProducer) backgroundFailure(payloads []ProducedPayload, err error) error {
+	var errs []error
+
+	for index := range payloads {
+		failErr := p.fail(&payloads[index], 1, err)
+
+		// Without a dead letter spool, fail returns the error the producer has already logged.
+		if failErr != nil && p.deadLetters != nil {
+			errs = append(errs, failErr)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// fail records an event that failed every attempt and writes it to the dead letter spool.
+// The error is returned when there is no dead letter spool or it cannot be written to.
+func (p *RetryingProducer) fail(payload *ProducedPayload, attempts int, err error) error {
+	RecordProducerFailure(p.identifier, p.name)
+
+	if p.deadLetters == nil {
+		return err
+	}
+
+	if spoolErr := p.deadLetters.Append(&DeadLetter{
+		FailedAt: time.Now(),
+		Producer: p.name,
+		Error:    err.Error(),
+		Attempts: attempts,
+		Payload:  *payload,
+	}); spoolErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to write dead letter: %w", spoolErr))
+	}
+
+	return nil
+}
+
+// publish publishes the event with retries and returns how many attempts were made.
+func (p *RetryingProducer) publish(ctx context.Context, shard *Shard, payload *ProducedPayload) (int, error) {
+	attempt := 0
+
+	for {
+		attempt++
+
+		err := p.producer.Publish(ctx, shard, payload)
+		if err == nil {
+			return attempt, nil
+		}
+
+		if attempt >= p.policy.MaxAttempts || !p.policy.retryable(err) {
+			return attempt, err
+		}
+
+		RecordProducerRetry(p.identifier, p.name)
+
+		select {
+		case <-ctx.Done():
+			return attempt, errors.Join(err, ctx.Err())
+		case <-time.After(p.policy.backoff(attempt)):
+		}
+	}
+}
+
+// Flush flushes the producer, if it buffers events, and the dead letter spool.
+func (p *RetryingProducer) Flush(ctx context.Context) error {
+	var errs []error
+
+	if flusher, ok := p.producer.(Flusher); ok {
+		errs = append(errs, flusher.Flush(ctx))
+	}
+
+	if p.deadLetters != nil {
+		errs = append(errs, p.deadLetters.Flush(ctx))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Close closes the producer. The dead letter spool is shared between producers, so it is closed by the application.
+func (p *RetryingProducer) Close() error {
+	return p.producer.Close()
+}
@@ -0,0 +1,155 @@
+package sandwich_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/WelcomerTeam/Discord/discord"
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyProducer fails to publish until it has been called failures times.
+type flakyProducer struct {
+	recordingProducer
+	failures int
+	calls    int
+}
+
+func (producer *flakyProducer) Publish(ctx context.Context, shard *sandwich.Shard, payload *sandwich.ProducedPayload) error {
+	producer.calls++
+
+	if producer.calls <= producer.failures {
+		return fmt.Errorf("attempt %d: %w", producer.calls, errors.New("unavailable"))
+	}
+
+	return producer.recordingProducer.Publish(ctx, shard, payload)
+}
+
+var testRetryPolicy = sandwich.RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     time.Millisecond * 5,
+	Multiplier:     2,
+}
+
+func TestRetryingProducerRetries(t *testing.T) {
+	t.Parallel()
+
+	flaky := &flakyProducer{failures: 2}
+	producer := sandwich.NewRetryingProducer("welcomer", sandwich.DefaultProducerName, flaky, testRetryPolicy, nil)
+
+	err := producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: "MESSAGE_CREATE"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, flaky.calls)
+	assert.Equal(t, []string{"MESSAGE_CREATE"}, flaky.events)
+
+	permanent := &recordingProducer{err: fmt.Errorf("invalid payload: %w", sandwich.ErrProducerPermanent)}
+	producer = sandwich.NewRetryingProducer("welcomer", sandwich.DefaultProducerName, permanent, testRetryPolicy, nil)
+
+	err = producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: "MESSAGE_CREATE"}})
+	assert.ErrorIs(t, err, sandwich.ErrProducerPermanent)
+	assert.Len(t, permanent.events, 1)
+}
+
+func TestDeadLetterSpoolReplay(t *testing.T) {
+	t.Parallel()
+
+	spool, err := sandwich.OpenDeadLetterSpool("welcomer", filepath.Join(t.TempDir(), "dead_letters.ndjson"), 0)
+	assert.NoError(t, err)
+
+	defer spool.Close()
+
+	flaky := &flakyProducer{failures: 3}
+	producer := sandwich.NewRetryingProducer("welcomer", sandwich.DefaultProducerName, flaky, testRetryPolicy, spool)
+
+	for _, eventType := range []string{"MESSAGE_CREATE", "GUILD_UPDATE"} {
+		err := producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{
+			GatewayPayload: discord.GatewayPayload{Type: eventType},
+			Metadata:       sandwich.ProducedMetadata{Identifier: "welcomer"},
+		})
+		assert.NoError(t, err)
+	}
+
+	_, count := spool.Size()
+	assert.Equal(t, int64(1), count)
+
+	// Dead letters that fail to replay are kept.
+	replayed, failed, err := spool.Replay(context.Background(), func(context.Context, *sandwich.DeadLetter) error {
+		return errors.New("still unavailable")
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), replayed)
+	assert.Equal(t, int64(1), failed)
+
+	_, count = spool.Size()
+	assert.Equal(t, int64(1), count)
+
+	replayed, failed, err = spool.Replay(context.Background(), func(_ context.Context, deadLetter *sandwich.DeadLetter) error {
+		assert.Equal(t, "MESSAGE_CREATE", deadLetter.Payload.Type)
+		assert.Equal(t, "welcomer", deadLetter.Payload.Metadata.Identifier)
+		assert.Equal(t, sandwich.DefaultProducerName, deadLetter.Producer)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), replayed)
+	assert.Equal(t, int64(0), failed)
+
+	size, count := spool.Size()
+	assert.Equal(t, int64(0), size)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestDeadLetterSpoolReplayKeepsUnreplayedWhenFull(t *testing.T) {
+	t.Parallel()
+
+	spool, err := sandwich.OpenDeadLetterSpool("welcomer", filepath.Join(t.TempDir(), "dead_letters.ndjson"), 4096)
+	assert.NoError(t, err)
+
+	defer spool.Close()
+
+	for _, eventType := range []string{"MESSAGE_CREATE", "GUILD_UPDATE", "GUILD_DELETE"} {
+		assert.NoError(t, spool.Append(&sandwich.DeadLetter{
+			Producer: sandwich.DefaultProducerName,
+			Payload:  sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: eventType}},
+		}))
+	}
+
+	// The second dead letter fails with an error too large to fit in the spool, so it cannot be appended again.
+	replayed, failed, err := spool.Replay(context.Background(), func(_ context.Context, deadLetter *sandwich.DeadLetter) error {
+		if deadLetter.Payload.Type != "MESSAGE_CREATE" {
+			return errors.New(strings.Repeat("unavailable ", 512))
+		}
+
+		return nil
+	})
+	assert.ErrorIs(t, err, sandwich.ErrDeadLetterSpoolFull)
+	assert.Equal(t, int64(1), replayed)
+	assert.Equal(t, int64(1), failed)
+
+	_, count := spool.Size()
+	assert.Equal(t, int64(2), count)
+
+	// Only the dead letters that were not replayed are replayed again.
+	eventTypes := make([]string, 0)
+
+	replayed, failed, err = spool.Replay(context.Background(), func(_ context.Context, deadLetter *sandwich.DeadLetter) error {
+		eventTypes = append(eventTypes, deadLetter.Payload.Type)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), replayed)
+	assert.Equal(t, int64(0), failed)
+	assert.Equal(t, []string{"GUILD_UPDATE", "GUILD_DELETE"}, eventTypes)
+
+	size, count := spool.Size()
+	assert.Equal(t, int64(0), size)
+	assert.Equal(t, int64(0), count)
+}
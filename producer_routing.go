@@ -0,0 +1,285 @@
+package sandwich
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/WelcomerTeam/Sandwich-Daemon/pkg/syncmap"
+)
+
+// ProducerRouteErrorPolicy is what happens when a producer of a route fails to publish an event.
+type ProducerRouteErrorPolicy string
+
+const (
+	// ProducerRouteErrorFail returns the error, so the event is reported as failed.
+	ProducerRouteErrorFail ProducerRouteErrorPolicy = "fail"
+	// ProducerRouteErrorIgnore logs the error and carries on.
+	ProducerRouteErrorIgnore ProducerRouteErrorPolicy = "ignore"
+)
+
+// ProducerRouteConfiguration publishes events of some types to one or more producers. Routes are checked in
+// order and the first route that matches an event publishes it, unless Continue is set. Events that match
+// no route are published to the default producer.
+type ProducerRouteConfiguration struct {
+	// Name is used in logs and metrics.
+	Name string `json:"name"`
+	// Events are the event types that the route matches. Patterns such as GUILD_* and * can be used.
+	Events []string `json:"events"`
+	// Producers are the names of the producers the event is published to, including default.
+	Producers []string `json:"producers"`
+	// Continue checks the routes after this one when it matches, so an event can be published by many routes.
+	Continue bool `json:"continue"`
+	// OnError is fail or ignore. Defaults to fail.
+	OnError ProducerRouteErrorPolicy `json:"on_error"`
+}
+
+// RoutedProducer is a producer that publishes events to other producers by their type.
+type RoutedProducer struct {
+	logger     *slog.Logger
+	identifier string
+
+	producers map[string]Producer
+	routes    []ProducerRouteConfiguration
+
+	// targets is the producers each event type is published to.
+	targets *syncmap.Map[string, []producerRouteTarget]
+}
+
+type producerRouteTarget struct {
+	route    string
+	name     string
+	producer Producer
+	onError  ProducerRouteErrorPolicy
+}
+
+// NewRoutedProducer returns a producer that publishes events to producers by routes. Producers are keyed by name
+// and must include DefaultProducerName. Identifier is the application identifier used in metrics.
+func NewRoutedProducer(logger *slog.Logger, identifier string, producers map[string]Producer, routes []ProducerRouteConfiguration) *RoutedProducer {
+	return &RoutedProducer{
+		logger:     logger,
+		identifier: identifier,
+
+		producers: producers,
+		routes:    routes,
+
+		targets: syncmap.NewSyncMap[string, []producerRouteTarget](),
+	}
+}
+
+// matchEventPattern returns true if an event type matches a pattern of a route.
+func matchEventPattern(pattern, eventType string) bool {
+	matched, _ := path.Match(pattern, eventType)
+
+	return matched
+}
+
+func validEventPattern(pattern string) bool {
+	_, err := path.Match(pattern, "")
+
+	return err == nil
+}
+
+// routeTargets returns the producers an event type is published to.
+func (p *RoutedProducer) routeTargets(eventType string) []producerRouteTarget {
+	if targets, ok := p.targets.Load(eventType); ok {
+		return targets
+	}
+
+	var targets []producerRouteTarget
+
+	published := make(map[string]bool)
+	matched := false
+
+	for _, route := range p.routes {
+		if !slices.ContainsFunc(route.Events, func(pattern string) bool { return matchEventPattern(pattern, eventType) }) {
+			continue
+		}
+
+		matched = true
+
+		for _, name := range route.Producers {
+			producer, ok := p.producers[name]
+			if !ok || published[name] {
+				continue
+			}
+
+			published[name] = true
+
+			targets = append(targets, producerRouteTarget{
+				route:    route.Name,
+				name:     name,
+				producer: producer,
+				onError:  route.OnError,
+			})
+		}
+
+		if !route.Continue {
+			break
+		}
+	}
+
+	if !matched {
+		if producer, ok := p.producers[DefaultProducerName]; ok {
+			targets = append(targets, producerRouteTarget{
+				route:    DefaultProducerName,
+				name:     DefaultProducerName,
+				producer: producer,
+				onError:  ProducerRouteErrorFail,
+			})
+		}
+	}
+
+	p.targets.Store(eventType, targets)
+
+	return targets
+}
+
+// Publish publishes the event to the producers of every route that matches it. When an event has more than one
+// producer, it is published to them concurrently, so a slow producer does not delay the others.
+func (p *RoutedProducer) Publish(ctx context.Context, shard *Shard, payload *ProducedPayload) error {
+	targets := p.routeTargets(payload.Type)
+
+	if len(targets) == 1 {
+		return p.publishTarget(ctx, shard, payload, targets[0])
+	}
+
+	errs := make([]error, len(targets))
+
+	var wg sync.WaitGroup
+
+	for index, target := range targets {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			errs[index] = p.publishTarget(ctx, shard, payload, target)
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// publishTarget publishes the event to one producer, returning the error if the route does not ignore it.
+func (p *RoutedProducer) publishTarget(ctx context.Context, shard *Shard, payload *ProducedPayload, target producerRouteTarget) error {
+	start := time.Now()
+	err := target.producer.Publish(ctx, shard, payload)
+
+	RecordProducerRoutePublish(p.identifier, target.route, target.name, time.Since(start), err)
+
+	if err == nil {
+		return nil
+	}
+
+	if target.onError == ProducerRouteErrorIgnore {
+		p.logger.Warn("Failed to publish event", "route", target.route, "producer", target.name, "type", payload.Type, "error", err)
+
+		return nil
+	}
+
+	return fmt.Errorf("producer %q of route %q: %w", target.name, target.route, err)
+}
+
+// Flush flushes every producer that buffers events.
+func (p *RoutedProducer) Flush(ctx context.Context) error {
+	var errs []error
+
+	for name, producer := range p.producers {
+		if flusher, ok := producer.(Flusher); ok {
+			if err := flusher.Flush(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("failed to flush producer %q: %w", name, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Close closes every producer.
+func (p *RoutedProducer) Close() error {
+	return closeProducers(p.producers)
+}
+
+func closeProducers(producers map[string]Producer) error {
+	var errs []error
+
+	for name, producer := range producers {
+		if err := producer.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close producer %q: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// newProducer creates the producer of an application. When producer routes are configured, the producer
+// from the ProducerProvider and the named producers are wrapped in a RoutedProducer. When retrying is
+// enabled, each producer is wrapped in a RetryingProducer that shares the returned dead letter spool.
+func (application *Application) newProducer(ctx context.Context, configuration *ApplicationConfiguration, clientName string) (Producer, *DeadLetterSpool, error) {
+	var deadLetters *DeadLetterSpool
+
+	retry := configuration.ProducerRetry
+
+	if retry.Enabled() && retry.DeadLetterPath != "" {
+		spool, err := OpenDeadLetterSpool(configuration.ApplicationIdentifier, retry.DeadLetterPath, retry.DeadLetterMaxBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		deadLetters = spool
+	}
+
+	wrap := func(name string, producer Producer) Producer {
+		if !retry.Enabled() {
+			return producer
+		}
+
+		return NewRetryingProducer(configuration.ApplicationIdentifier, name, producer, retry.Policy(), deadLetters)
+	}
+
+	producer, err := application.Sandwich.producerProvider.GetProducer(ctx, configuration.ApplicationIdentifier, clientName)
+	if err != nil {
+		return nil, nil, errors.Join(err, deadLetters.Close())
+	}
+
+	if len(configuration.ProducerRoutes) == 0 {
+		producer, err = application.spoolProducer(configuration, wrap(DefaultProducerName, producer))
+		if err != nil {
+			return nil, nil, errors.Join(err, deadLetters.Close())
+		}
+
+		return producer, deadLetters, nil
+	}
+
+	producers := map[string]Producer{
+		DefaultProducerName: wrap(DefaultProducerName, producer),
+	}
+
+	for _, producerConfiguration := range configuration.Producers {
+		namedProducer, err := application.newNamedProducer(ctx, producerConfiguration, clientName)
+		if err != nil {
+			if closeErr := errors.Join(closeProducers(producers), deadLetters.Close()); closeErr != nil {
+				application.Logger.Error("Failed to close producers", "error", closeErr)
+			}
+
+			return nil, nil, fmt.Errorf("failed to create producer %q: %w", producerConfiguration.Name, err)
+		}
+
+		producers[producerConfiguration.Name] = wrap(producerConfiguration.Name, namedProducer)
+	}
+
+	producer, err = application.spoolProducer(configuration, NewRoutedProducer(application.Logger, configuration.ApplicationIdentifier, producers, configuration.ProducerRoutes))
+	if err != nil {
+		return nil, nil, errors.Join(err, deadLetters.Close())
+	}
+
+	return producer, deadLetters, nil
+}
@@ -0,0 +1,127 @@
+package sandwich_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/WelcomerTeam/Discord/discord"
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingProducer struct {
+	mu     sync.Mutex
+	events []string
+	err    error
+}
+
+func (producer *recordingProducer) Publish(_ context.Context, _ *sandwich.Shard, payload *sandwich.ProducedPayload) error {
+	producer.mu.Lock()
+	defer producer.mu.Unlock()
+
+	producer.events = append(producer.events, payload.Type)
+
+	return producer.err
+}
+
+func (producer *recordingProducer) Close() error {
+	return nil
+}
+
+func TestRoutedProducerPublish(t *testing.T) {
+	t.Parallel()
+
+	defaultProducer := &recordingProducer{}
+	interactions := &recordingProducer{}
+	audit := &recordingProducer{err: errors.New("unavailable")}
+
+	producer := sandwich.NewRoutedProducer(slog.Default(), "welcomer", map[string]sandwich.Producer{
+		sandwich.DefaultProducerName: defaultProducer,
+		"interactions":               interactions,
+		"audit":                      audit,
+	}, []sandwich.ProducerRouteConfiguration{
+		{Name: "interactions", Events: []string{"INTERACTION_CREATE"}, Producers: []string{"interactions"}},
+		{Name: "audit", Events: []string{"GUILD_AUDIT_LOG_*"}, Producers: []string{"audit"}, OnError: sandwich.ProducerRouteErrorIgnore, Continue: true},
+		{Name: "guilds", Events: []string{"GUILD_*"}, Producers: []string{"audit", sandwich.DefaultProducerName}},
+	})
+
+	publish := func(eventType string) error {
+		return producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{
+			GatewayPayload: discord.GatewayPayload{Type: eventType},
+		})
+	}
+
+	assert.NoError(t, publish("INTERACTION_CREATE"))
+	assert.NoError(t, publish("MESSAGE_CREATE"))
+	assert.NoError(t, publish("GUILD_AUDIT_LOG_ENTRY_CREATE"))
+
+	err := publish("GUILD_UPDATE")
+	assert.ErrorContains(t, err, `producer "audit" of route "guilds"`)
+
+	assert.Equal(t, []string{"INTERACTION_CREATE"}, interactions.events)
+	assert.Equal(t, []string{"GUILD_AUDIT_LOG_ENTRY_CREATE", "GUILD_UPDATE"}, audit.events)
+	assert.Equal(t, []string{"MESSAGE_CREATE", "GUILD_AUDIT_LOG_ENTRY_CREATE", "GUILD_UPDATE"}, defaultProducer.events)
+}
+
+// blockingProducer blocks publishing until it is released.
+type blockingProducer struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (producer *blockingProducer) Publish(ctx context.Context, _ *sandwich.Shard, _ *sandwich.ProducedPayload) error {
+	producer.started <- struct{}{}
+
+	select {
+	case <-producer.release:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (producer *blockingProducer) Close() error {
+	return nil
+}
+
+func TestRoutedProducerPublishConcurrently(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+
+	producer := sandwich.NewRoutedProducer(slog.Default(), "welcomer", map[string]sandwich.Producer{
+		sandwich.DefaultProducerName: &blockingProducer{started: started, release: release},
+		"audit":                      &blockingProducer{started: started, release: release},
+	}, []sandwich.ProducerRouteConfiguration{
+		{Name: "guilds", Events: []string{"GUILD_*"}, Producers: []string{"audit", sandwich.DefaultProducerName}},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	published := make(chan error, 1)
+
+	go func() {
+		published <- producer.Publish(ctx, nil, &sandwich.ProducedPayload{
+			GatewayPayload: discord.GatewayPayload{Type: "GUILD_UPDATE"},
+		})
+	}()
+
+	// Both producers are publishing before either has returned.
+	for range 2 {
+		select {
+		case <-started:
+		case <-ctx.Done():
+			t.Fatal("event was not published to both producers at once")
+		}
+	}
+
+	close(release)
+
+	assert.NoError(t, <-published)
+}
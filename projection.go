@@ -0,0 +1,517 @@
+package sandwich
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var errInvalidJSON = errors.New("invalid json")
+
+// PayloadProjectionConfiguration removes fields from the payload of an event before it is published.
+// Paths are dot separated, such as author.id, and * matches every element of a list or every key of an object,
+// such as members.*.user.id.
+type PayloadProjectionConfiguration struct {
+	// Include is the paths to keep. When empty, every field is kept.
+	Include []string `json:"include"`
+	// Exclude is the paths to remove, applied after Include.
+	Exclude []string `json:"exclude"`
+}
+
+// PayloadProjection is a compiled PayloadProjectionConfiguration.
+type PayloadProjection struct {
+	include *projectionNode
+	exclude *projectionNode
+}
+
+// projectionNode is a tree of paths.
+type projectionNode struct {
+	children map[string]*projectionNode
+
+	// terminal is true when a path ends at the node, so it matches the whole value.
+	terminal bool
+}
+
+func (node *projectionNode) isLeaf() bool {
+	return node.terminal
+}
+
+// child returns the node for a key, falling back to the wildcard.
+func (node *projectionNode) child(key []byte) *projectionNode {
+	if child, ok := node.children[string(key)]; ok {
+		return child
+	}
+
+	return node.children["*"]
+}
+
+func compileProjectionPaths(paths []string) (*projectionNode, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	root := &projectionNode{children: make(map[string]*projectionNode)}
+
+	for _, path := range paths {
+		segments := strings.Split(path, ".")
+		if path == "" || strings.Contains(path, "..") || segments[0] == "" || segments[len(segments)-1] == "" {
+			return nil, fmt.Errorf("invalid path %q", path)
+		}
+
+		node := root
+
+		for _, segment := range segments {
+			// A shorter path already matches the whole value.
+			if node.terminal {
+				break
+			}
+
+			child, ok := node.children[segment]
+			if !ok {
+				child = &projectionNode{children: make(map[string]*projectionNode)}
+				node.children[segment] = child
+			}
+
+			node = child
+		}
+
+		// The whole value is matched, so anything more specific under it is not needed.
+		node.terminal = true
+		node.children = nil
+	}
+
+	mergeProjectionWildcards(root)
+
+	return root, nil
+}
+
+// mergeProjectionWildcards merges the wildcard of a node into its other children, as child only
+// falls back to the wildcard when a key has no node of its own. Without this, include ["*.id", "author.username"]
+// would drop author.id.
+func mergeProjectionWildcards(node *projectionNode) {
+	if wildcard, ok := node.children["*"]; ok {
+		for key, child := range node.children {
+			if key != "*" {
+				mergeProjectionNode(child, wildcard)
+			}
+		}
+	}
+
+	for _, child := range node.children {
+		mergeProjectionWildcards(child)
+	}
+}
+
+// mergeProjectionNode adds the paths of src to dst.
+func mergeProjectionNode(dst, src *projectionNode) {
+	if dst.terminal {
+		return
+	}
+
+	if src.terminal {
+		dst.terminal = true
+		dst.children = nil
+
+		return
+	}
+
+	for key, srcChild := range src.children {
+		dstChild, ok := dst.children[key]
+		if !ok {
+			dstChild = &projectionNode{children: make(map[string]*projectionNode)}
+			dst.children[key] = dstChild
+		}
+
+		mergeProjectionNode(dstChild, srcChild)
+	}
+}
+
+// CompilePayloadProjection compiles a projection so it can be applied to payloads.
+func CompilePayloadProjection(config PayloadProjectionConfiguration) (*PayloadProjection, error) {
+	include, err := compileProjectionPaths(config.Include)
+	if err != nil {
+		return nil, fmt.Errorf("include: %w", err)
+	}
+
+	exclude, err := compileProjectionPaths(config.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("exclude: %w", err)
+	}
+
+	return &PayloadProjection{
+		include: include,
+		exclude: exclude,
+	}, nil
+}
+
+// Apply returns the payload with the projection applied. The payload is scanned rather than decoded,
+// so only the fields that are kept are copied.
+func (projection *PayloadProjection) Apply(data []byte) ([]byte, error) {
+	if projection == nil || (projection.include == nil && projection.exclude == nil) {
+		return data, nil
+	}
+
+	var err error
+
+	if projection.include != nil {
+		buffer := bytes.NewBuffer(make([]byte, 0, len(data)/2))
+
+		if err = projectInclude(buffer, data, projection.include); err != nil {
+			return nil, err
+		}
+
+		data = buffer.Bytes()
+	}
+
+	if projection.exclude != nil {
+		buffer := bytes.NewBuffer(make([]byte, 0, len(data)))
+
+		if err = projectExclude(buffer, data, projection.exclude); err != nil {
+			return nil, err
+		}
+
+		data = buffer.Bytes()
+	}
+
+	return data, nil
+}
+
+// projectInclude writes only the parts of value that are matched by node.
+func projectInclude(buffer *bytes.Buffer, value []byte, node *projectionNode) error {
+	value = bytes.TrimSpace(value)
+
+	if node.isLeaf() || len(value) == 0 {
+		buffer.Write(value)
+
+		return nil
+	}
+
+	switch value[0] {
+	case '{':
+		buffer.WriteByte('{')
+
+		first := true
+
+		err := scanJSONObject(value, func(key, rawKey, member []byte) error {
+			child := node.child(key)
+			if child == nil {
+				return nil
+			}
+
+			if !first {
+				buffer.WriteByte(',')
+			}
+
+			first = false
+
+			buffer.Write(rawKey)
+			buffer.WriteByte(':')
+
+			return projectInclude(buffer, member, child)
+		})
+
+		buffer.WriteByte('}')
+
+		return err
+	case '[':
+		child := node.children["*"]
+		if child == nil {
+			buffer.WriteString("[]")
+
+			return nil
+		}
+
+		buffer.WriteByte('[')
+
+		first := true
+
+		err := scanJSONArray(value, func(element []byte) error {
+			if !first {
+				buffer.WriteByte(',')
+			}
+
+			first = false
+
+			return projectInclude(buffer, element, child)
+		})
+
+		buffer.WriteByte(']')
+
+		return err
+	}
+
+	// A path into a value that is not an object or list matches nothing.
+	buffer.WriteString("null")
+
+	return nil
+}
+
+// projectExclude writes value without the parts that are matched by node.
+func projectExclude(buffer *bytes.Buffer, value []byte, node *projectionNode) error {
+	value = bytes.TrimSpace(value)
+
+	if node.isLeaf() || len(value) == 0 {
+		buffer.Write(value)
+
+		return nil
+	}
+
+	switch value[0] {
+	case '{':
+		buffer.WriteByte('{')
+
+		first := true
+
+		err := scanJSONObject(value, func(key, rawKey, member []byte) error {
+			child := node.child(key)
+			if child != nil && child.isLeaf() {
+				return nil
+			}
+
+			if !first {
+				buffer.WriteByte(',')
+			}
+
+			first = false
+
+			buffer.Write(rawKey)
+			buffer.WriteByte(':')
+
+			if child == nil {
+				buffer.Write(bytes.TrimSpace(member))
+
+				return nil
+			}
+
+			return projectExclude(buffer, member, child)
+		})
+
+		buffer.WriteByte('}')
+
+		return err
+	case '[':
+		child := node.children["*"]
+		if child == nil {
+			buffer.Write(value)
+
+			return nil
+		}
+
+		if child.isLeaf() {
+			buffer.WriteString("[]")
+
+			return nil
+		}
+
+		buffer.WriteByte('[')
+
+		first := true
+
+		err := scanJSONArray(value, func(element []byte) error {
+			if !first {
+				buffer.WriteByte(',')
+			}
+
+			first = false
+
+			return projectExclude(buffer, element, child)
+		})
+
+		buffer.WriteByte(']')
+
+		return err
+	}
+
+	buffer.Write(value)
+
+	return nil
+}
+
+// scanJSONObject calls fn with every member of a JSON object.
+func scanJSONObject(data []byte, fn func(key, rawKey, value []byte) error) error {
+	index := skipJSONWhitespace(data, 0)
+	if index >= len(data) || data[index] != '{' {
+		return errInvalidJSON
+	}
+
+	index = skipJSONWhitespace(data, index+1)
+	if index < len(data) && data[index] == '}' {
+		return nil
+	}
+
+	for index < len(data) {
+		if data[index] != '"' {
+			return errInvalidJSON
+		}
+
+		keyEnd, err := skipJSONValue(data, index)
+		if err != nil {
+			return err
+		}
+
+		rawKey := data[index:keyEnd]
+
+		key := rawKey[1 : len(rawKey)-1]
+		if bytes.IndexByte(rawKey, '\\') >= 0 {
+			unquoted, err := strconv.Unquote(string(rawKey))
+			if err != nil {
+				return errInvalidJSON
+			}
+
+			key = []byte(unquoted)
+		}
+
+		index = skipJSONWhitespace(data, keyEnd)
+		if index >= len(data) || data[index] != ':' {
+			return errInvalidJSON
+		}
+
+		valueStart := skipJSONWhitespace(data, index+1)
+
+		valueEnd, err := skipJSONValue(data, valueStart)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(key, rawKey, data[valueStart:valueEnd]); err != nil {
+			return err
+		}
+
+		index = skipJSONWhitespace(data, valueEnd)
+		if index >= len(data) {
+			return errInvalidJSON
+		}
+
+		switch data[index] {
+		case ',':
+			index = skipJSONWhitespace(data, index+1)
+		case '}':
+			return nil
+		default:
+			return errInvalidJSON
+		}
+	}
+
+	return errInvalidJSON
+}
+
+// scanJSONArray calls fn with every element of a JSON array.
+func scanJSONArray(data []byte, fn func(value []byte) error) error {
+	index := skipJSONWhitespace(data, 0)
+	if index >= len(data) || data[index] != '[' {
+		return errInvalidJSON
+	}
+
+	index = skipJSONWhitespace(data, index+1)
+	if index < len(data) && data[index] == ']' {
+		return nil
+	}
+
+	for index < len(data) {
+		valueEnd, err := skipJSONValue(data, index)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(data[index:valueEnd]); err != nil {
+			return err
+		}
+
+		index = skipJSONWhitespace(data, valueEnd)
+		if index >= len(data) {
+			return errInvalidJSON
+		}
+
+		switch data[index] {
+		case ',':
+			index = skipJSONWhitespace(data, index+1)
+		case ']':
+			return nil
+		default:
+			return errInvalidJSON
+		}
+	}
+
+	return errInvalidJSON
+}
+
+// skipJSONValue returns the index after the value that starts at index.
+func skipJSONValue(data []byte, index int) (int, error) {
+	if index >= len(data) {
+		return 0, errInvalidJSON
+	}
+
+	switch data[index] {
+	case '"':
+		for index++; index < len(data); index++ {
+			switch data[index] {
+			case '\\':
+				index++
+			case '"':
+				return index + 1, nil
+			}
+		}
+
+		return 0, errInvalidJSON
+	case '{', '[':
+		depth := 0
+
+		for ; index < len(data); index++ {
+			switch data[index] {
+			case '"':
+				end, err := skipJSONValue(data, index)
+				if err != nil {
+					return 0, err
+				}
+
+				index = end - 1
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+
+				if depth == 0 {
+					return index + 1, nil
+				}
+			}
+		}
+
+		return 0, errInvalidJSON
+	}
+
+	start := index
+
+	for index < len(data) {
+		switch data[index] {
+		case ',', '}', ']', ' ', '\t', '\n', '\r':
+			if index == start {
+				return 0, errInvalidJSON
+			}
+
+			return index, nil
+		}
+
+		index++
+	}
+
+	if index == start {
+		return 0, errInvalidJSON
+	}
+
+	return index, nil
+}
+
+func skipJSONWhitespace(data []byte, index int) int {
+	for index < len(data) {
+		switch data[index] {
+		case ' ', '\t', '\n', '\r':
+			index++
+		default:
+			return index
+		}
+	}
+
+	return index
+}
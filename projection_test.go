@@ -0,0 +1,148 @@
+package sandwich_test
+
+import (
+	"testing"
+
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+const testGuildCreate = `{"id":"1","name":"guild","roles":[{"id":"2","name":"a"},{"id":"3","name":"b"}],` +
+	`"members":[{"user":{"id":"4","username":"c"},"roles":["2"]},{"user":{"id":"5","username":"d"},"roles":[]}],` +
+	`"presences":[{"user":{"id":"4"},"status":"online"}],"owner":{"id":"4","name":"e\"f"}}`
+
+func TestPayloadProjectionApply(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		config   sandwich.PayloadProjectionConfiguration
+		expected string
+	}{
+		{
+			name:     "empty",
+			config:   sandwich.PayloadProjectionConfiguration{},
+			expected: testGuildCreate,
+		},
+		{
+			name:   "exclude",
+			config: sandwich.PayloadProjectionConfiguration{Exclude: []string{"members", "presences"}},
+			expected: `{"id":"1","name":"guild","roles":[{"id":"2","name":"a"},{"id":"3","name":"b"}],` +
+				`"owner":{"id":"4","name":"e\"f"}}`,
+		},
+		{
+			name:     "include",
+			config:   sandwich.PayloadProjectionConfiguration{Include: []string{"id", "owner.id"}},
+			expected: `{"id":"1","owner":{"id":"4"}}`,
+		},
+		{
+			name:     "include wildcard",
+			config:   sandwich.PayloadProjectionConfiguration{Include: []string{"id", "members.*.user.id"}},
+			expected: `{"id":"1","members":[{"user":{"id":"4"}},{"user":{"id":"5"}}]}`,
+		},
+		{
+			name:     "include overlapping paths",
+			config:   sandwich.PayloadProjectionConfiguration{Include: []string{"owner.id", "owner"}},
+			expected: `{"owner":{"id":"4","name":"e\"f"}}`,
+		},
+		{
+			name: "include and exclude",
+			config: sandwich.PayloadProjectionConfiguration{
+				Include: []string{"id", "roles"},
+				Exclude: []string{"roles.*.name"},
+			},
+			expected: `{"id":"1","roles":[{"id":"2"},{"id":"3"}]}`,
+		},
+		{
+			name:     "exclude wildcard",
+			config:   sandwich.PayloadProjectionConfiguration{Exclude: []string{"members.*.roles", "presences.*", "roles", "name", "owner"}},
+			expected: `{"id":"1","members":[{"user":{"id":"4","username":"c"}},{"user":{"id":"5","username":"d"}}],"presences":[]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			projection, err := sandwich.CompilePayloadProjection(test.config)
+			assert.NoError(t, err)
+
+			data, err := projection.Apply([]byte(testGuildCreate))
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(data))
+		})
+	}
+}
+
+func TestPayloadProjectionWildcardAndKey(t *testing.T) {
+	t.Parallel()
+
+	const payload = `{"author":{"id":"1","username":"a","avatar":"b"},"channel":{"id":"2","name":"c"}}`
+
+	tests := []struct {
+		name     string
+		config   sandwich.PayloadProjectionConfiguration
+		expected string
+	}{
+		{
+			name:     "include",
+			config:   sandwich.PayloadProjectionConfiguration{Include: []string{"*.id", "author.username"}},
+			expected: `{"author":{"id":"1","username":"a"},"channel":{"id":"2"}}`,
+		},
+		{
+			name:     "include whole value",
+			config:   sandwich.PayloadProjectionConfiguration{Include: []string{"*", "author.username"}},
+			expected: payload,
+		},
+		{
+			name:     "exclude",
+			config:   sandwich.PayloadProjectionConfiguration{Exclude: []string{"*.id", "author.username"}},
+			expected: `{"author":{"avatar":"b"},"channel":{"name":"c"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			projection, err := sandwich.CompilePayloadProjection(test.config)
+			assert.NoError(t, err)
+
+			data, err := projection.Apply([]byte(payload))
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(data))
+		})
+	}
+}
+
+func TestPayloadProjectionErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range []string{"", ".id", "id.", "members..user"} {
+		_, err := sandwich.CompilePayloadProjection(sandwich.PayloadProjectionConfiguration{Include: []string{path}})
+		assert.Error(t, err, path)
+	}
+
+	projection, err := sandwich.CompilePayloadProjection(sandwich.PayloadProjectionConfiguration{Exclude: []string{"members"}})
+	assert.NoError(t, err)
+
+	for _, data := range []string{`{"id":"1"`, `{"id" "1"}`, `{"members":[1,2}`, `{"id":"1}`} {
+		_, err := projection.Apply([]byte(data))
+		assert.Error(t, err, data)
+	}
+}
+
+func BenchmarkPayloadProjectionApply(b *testing.B) {
+	projection, err := sandwich.CompilePayloadProjection(sandwich.PayloadProjectionConfiguration{
+		Exclude: []string{"members", "presences"},
+	})
+	assert.NoError(b, err)
+
+	data := []byte(testGuildCreate)
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_, _ = projection.Apply(data)
+	}
+}
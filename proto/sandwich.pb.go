@@ -130,6 +130,10 @@ type ListenResponse struct {
 
 	Timestamp int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Data      []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Sequence is incremented for every broadcast. Gaps indicate messages dropped for this listener.
+	Sequence int64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Dropped is the total number of messages dropped for this listener so far.
+	Dropped int64 `protobuf:"varint,4,opt,name=dropped,proto3" json:"dropped,omitempty"`
 }
 
 func (x *ListenResponse) Reset() {
@@ -178,6 +182,531 @@ func (x *ListenResponse) GetData() []byte {
 	return nil
 }
 
+func (x *ListenResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ListenResponse) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type ReconcileConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ReconcileConfigurationRequest) Reset() {
+	*x = ReconcileConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileConfigurationRequest) ProtoMessage() {}
+
+func (x *ReconcileConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReconcileConfigurationRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ReconcileConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResponse *BaseResponse          `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	DryRun       bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Changes      []*ConfigurationChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ReconcileConfigurationResponse) Reset() {
+	*x = ReconcileConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileConfigurationResponse) ProtoMessage() {}
+
+func (x *ReconcileConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReconcileConfigurationResponse) GetBaseResponse() *BaseResponse {
+	if x != nil {
+		return x.BaseResponse
+	}
+	return nil
+}
+
+func (x *ReconcileConfigurationResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ReconcileConfigurationResponse) GetChanges() []*ConfigurationChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ConfigurationChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIdentifier string   `protobuf:"bytes,1,opt,name=application_identifier,json=applicationIdentifier,proto3" json:"application_identifier,omitempty"`
+	Action                string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Fields                []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Error                 string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ConfigurationChange) Reset() {
+	*x = ConfigurationChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigurationChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationChange) ProtoMessage() {}
+
+func (x *ConfigurationChange) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationChange.ProtoReflect.Descriptor instead.
+func (*ConfigurationChange) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConfigurationChange) GetApplicationIdentifier() string {
+	if x != nil {
+		return x.ApplicationIdentifier
+	}
+	return ""
+}
+
+func (x *ConfigurationChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ConfigurationChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ConfigurationChange) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ValidateConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configuration []byte `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+}
+
+func (x *ValidateConfigurationRequest) Reset() {
+	*x = ValidateConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigurationRequest) ProtoMessage() {}
+
+func (x *ValidateConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ValidateConfigurationRequest) GetConfiguration() []byte {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type ValidateConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResponse *BaseResponse         `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	Errors       []*ConfigurationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ValidateConfigurationResponse) Reset() {
+	*x = ValidateConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigurationResponse) ProtoMessage() {}
+
+func (x *ValidateConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidateConfigurationResponse) GetBaseResponse() *BaseResponse {
+	if x != nil {
+		return x.BaseResponse
+	}
+	return nil
+}
+
+func (x *ValidateConfigurationResponse) GetErrors() []*ConfigurationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ConfigurationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field   string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ConfigurationError) Reset() {
+	*x = ConfigurationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigurationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationError) ProtoMessage() {}
+
+func (x *ConfigurationError) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationError.ProtoReflect.Descriptor instead.
+func (*ConfigurationError) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConfigurationError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ConfigurationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListConfigVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResponse *BaseResponse    `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	Versions     []*ConfigVersion `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ListConfigVersionsResponse) Reset() {
+	*x = ListConfigVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigVersionsResponse) ProtoMessage() {}
+
+func (x *ListConfigVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListConfigVersionsResponse) GetBaseResponse() *BaseResponse {
+	if x != nil {
+		return x.BaseResponse
+	}
+	return nil
+}
+
+func (x *ListConfigVersionsResponse) GetVersions() []*ConfigVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type ConfigVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version   int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Size      int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ConfigVersion) Reset() {
+	*x = ConfigVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigVersion) ProtoMessage() {}
+
+func (x *ConfigVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigVersion.ProtoReflect.Descriptor instead.
+func (*ConfigVersion) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConfigVersion) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ConfigVersion) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ConfigVersion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ConfigVersion) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type RollbackConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RollbackConfigRequest) Reset() {
+	*x = RollbackConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackConfigRequest) ProtoMessage() {}
+
+func (x *RollbackConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackConfigRequest.ProtoReflect.Descriptor instead.
+func (*RollbackConfigRequest) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RollbackConfigRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ApplicationIdentifier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -186,23 +715,127 @@ type ApplicationIdentifier struct {
 	ApplicationIdentifier string `protobuf:"bytes,1,opt,name=application_identifier,json=applicationIdentifier,proto3" json:"application_identifier,omitempty"`
 }
 
-func (x *ApplicationIdentifier) Reset() {
-	*x = ApplicationIdentifier{}
+func (x *ApplicationIdentifier) Reset() {
+	*x = ApplicationIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationIdentifier) ProtoMessage() {}
+
+func (x *ApplicationIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationIdentifier.ProtoReflect.Descriptor instead.
+func (*ApplicationIdentifier) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ApplicationIdentifier) GetApplicationIdentifier() string {
+	if x != nil {
+		return x.ApplicationIdentifier
+	}
+	return ""
+}
+
+type ApplicationIdentifierWithBlocking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIdentifier string `protobuf:"bytes,1,opt,name=application_identifier,json=applicationIdentifier,proto3" json:"application_identifier,omitempty"`
+	Blocking              bool   `protobuf:"varint,2,opt,name=blocking,proto3" json:"blocking,omitempty"`
+}
+
+func (x *ApplicationIdentifierWithBlocking) Reset() {
+	*x = ApplicationIdentifierWithBlocking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationIdentifierWithBlocking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationIdentifierWithBlocking) ProtoMessage() {}
+
+func (x *ApplicationIdentifierWithBlocking) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationIdentifierWithBlocking.ProtoReflect.Descriptor instead.
+func (*ApplicationIdentifierWithBlocking) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApplicationIdentifierWithBlocking) GetApplicationIdentifier() string {
+	if x != nil {
+		return x.ApplicationIdentifier
+	}
+	return ""
+}
+
+func (x *ApplicationIdentifierWithBlocking) GetBlocking() bool {
+	if x != nil {
+		return x.Blocking
+	}
+	return false
+}
+
+// FetchApplicationRequest is wire compatible with ApplicationIdentifier.
+type FetchApplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIdentifier string `protobuf:"bytes,1,opt,name=application_identifier,json=applicationIdentifier,proto3" json:"application_identifier,omitempty"`
+	IncludeBotToken       bool   `protobuf:"varint,2,opt,name=include_bot_token,json=includeBotToken,proto3" json:"include_bot_token,omitempty"`
+}
+
+func (x *FetchApplicationRequest) Reset() {
+	*x = FetchApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[3]
+		mi := &file_sandwich_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ApplicationIdentifier) String() string {
+func (x *FetchApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationIdentifier) ProtoMessage() {}
+func (*FetchApplicationRequest) ProtoMessage() {}
 
-func (x *ApplicationIdentifier) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[3]
+func (x *FetchApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,44 +846,54 @@ func (x *ApplicationIdentifier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationIdentifier.ProtoReflect.Descriptor instead.
-func (*ApplicationIdentifier) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use FetchApplicationRequest.ProtoReflect.Descriptor instead.
+func (*FetchApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ApplicationIdentifier) GetApplicationIdentifier() string {
+func (x *FetchApplicationRequest) GetApplicationIdentifier() string {
 	if x != nil {
 		return x.ApplicationIdentifier
 	}
 	return ""
 }
 
-type ApplicationIdentifierWithBlocking struct {
+func (x *FetchApplicationRequest) GetIncludeBotToken() bool {
+	if x != nil {
+		return x.IncludeBotToken
+	}
+	return false
+}
+
+type RotateApplicationTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ApplicationIdentifier string `protobuf:"bytes,1,opt,name=application_identifier,json=applicationIdentifier,proto3" json:"application_identifier,omitempty"`
-	Blocking              bool   `protobuf:"varint,2,opt,name=blocking,proto3" json:"blocking,omitempty"`
+	// bot_token is the new token, or a reference to it such as env:NAME.
+	BotToken string `protobuf:"bytes,2,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`
+	// save_config saves the configuration, which requires bot_token to be a reference.
+	SaveConfig bool `protobuf:"varint,3,opt,name=save_config,json=saveConfig,proto3" json:"save_config,omitempty"`
 }
 
-func (x *ApplicationIdentifierWithBlocking) Reset() {
-	*x = ApplicationIdentifierWithBlocking{}
+func (x *RotateApplicationTokenRequest) Reset() {
+	*x = RotateApplicationTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[4]
+		mi := &file_sandwich_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ApplicationIdentifierWithBlocking) String() string {
+func (x *RotateApplicationTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationIdentifierWithBlocking) ProtoMessage() {}
+func (*RotateApplicationTokenRequest) ProtoMessage() {}
 
-func (x *ApplicationIdentifierWithBlocking) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[4]
+func (x *RotateApplicationTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,25 +904,95 @@ func (x *ApplicationIdentifierWithBlocking) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationIdentifierWithBlocking.ProtoReflect.Descriptor instead.
-func (*ApplicationIdentifierWithBlocking) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use RotateApplicationTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateApplicationTokenRequest) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ApplicationIdentifierWithBlocking) GetApplicationIdentifier() string {
+func (x *RotateApplicationTokenRequest) GetApplicationIdentifier() string {
 	if x != nil {
 		return x.ApplicationIdentifier
 	}
 	return ""
 }
 
-func (x *ApplicationIdentifierWithBlocking) GetBlocking() bool {
+func (x *RotateApplicationTokenRequest) GetBotToken() string {
 	if x != nil {
-		return x.Blocking
+		return x.BotToken
+	}
+	return ""
+}
+
+func (x *RotateApplicationTokenRequest) GetSaveConfig() bool {
+	if x != nil {
+		return x.SaveConfig
 	}
 	return false
 }
 
+type ReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResponse *BaseResponse `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	Replayed     int64         `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	Failed       int64         `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReplayDeadLettersResponse) GetBaseResponse() *BaseResponse {
+	if x != nil {
+		return x.BaseResponse
+	}
+	return nil
+}
+
+func (x *ReplayDeadLettersResponse) GetReplayed() int64 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+func (x *ReplayDeadLettersResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
 type FetchApplicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -292,7 +1005,7 @@ type FetchApplicationResponse struct {
 func (x *FetchApplicationResponse) Reset() {
 	*x = FetchApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[5]
+		mi := &file_sandwich_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -305,7 +1018,7 @@ func (x *FetchApplicationResponse) String() string {
 func (*FetchApplicationResponse) ProtoMessage() {}
 
 func (x *FetchApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[5]
+	mi := &file_sandwich_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -318,7 +1031,7 @@ func (x *FetchApplicationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchApplicationResponse.ProtoReflect.Descriptor instead.
 func (*FetchApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{5}
+	return file_sandwich_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *FetchApplicationResponse) GetBaseResponse() *BaseResponse {
@@ -340,6 +1053,7 @@ type CreateApplicationRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// save_config saves the configuration, which requires bot_token to be a reference.
 	SaveConfig            bool     `protobuf:"varint,1,opt,name=save_config,json=saveConfig,proto3" json:"save_config,omitempty"`
 	ApplicationIdentifier string   `protobuf:"bytes,3,opt,name=application_identifier,json=applicationIdentifier,proto3" json:"application_identifier,omitempty"`
 	ProducerIdentifier    string   `protobuf:"bytes,4,opt,name=producer_identifier,json=producerIdentifier,proto3" json:"producer_identifier,omitempty"`
@@ -362,7 +1076,7 @@ type CreateApplicationRequest struct {
 func (x *CreateApplicationRequest) Reset() {
 	*x = CreateApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[6]
+		mi := &file_sandwich_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -375,7 +1089,7 @@ func (x *CreateApplicationRequest) String() string {
 func (*CreateApplicationRequest) ProtoMessage() {}
 
 func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[6]
+	mi := &file_sandwich_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,7 +1102,7 @@ func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
 func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{6}
+	return file_sandwich_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateApplicationRequest) GetSaveConfig() bool {
@@ -531,7 +1245,7 @@ type SandwichApplication struct {
 func (x *SandwichApplication) Reset() {
 	*x = SandwichApplication{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[7]
+		mi := &file_sandwich_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -544,7 +1258,7 @@ func (x *SandwichApplication) String() string {
 func (*SandwichApplication) ProtoMessage() {}
 
 func (x *SandwichApplication) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[7]
+	mi := &file_sandwich_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -557,7 +1271,7 @@ func (x *SandwichApplication) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SandwichApplication.ProtoReflect.Descriptor instead.
 func (*SandwichApplication) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{7}
+	return file_sandwich_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SandwichApplication) GetApplicationIdentifier() string {
@@ -657,7 +1371,7 @@ type Shard struct {
 func (x *Shard) Reset() {
 	*x = Shard{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[8]
+		mi := &file_sandwich_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -670,7 +1384,7 @@ func (x *Shard) String() string {
 func (*Shard) ProtoMessage() {}
 
 func (x *Shard) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[8]
+	mi := &file_sandwich_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,7 +1397,7 @@ func (x *Shard) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Shard.ProtoReflect.Descriptor instead.
 func (*Shard) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{8}
+	return file_sandwich_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Shard) GetId() int32 {
@@ -768,7 +1482,7 @@ type RequestGuildChunkRequest struct {
 func (x *RequestGuildChunkRequest) Reset() {
 	*x = RequestGuildChunkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[9]
+		mi := &file_sandwich_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -781,7 +1495,7 @@ func (x *RequestGuildChunkRequest) String() string {
 func (*RequestGuildChunkRequest) ProtoMessage() {}
 
 func (x *RequestGuildChunkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[9]
+	mi := &file_sandwich_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -794,7 +1508,7 @@ func (x *RequestGuildChunkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestGuildChunkRequest.ProtoReflect.Descriptor instead.
 func (*RequestGuildChunkRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{9}
+	return file_sandwich_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RequestGuildChunkRequest) GetGuildId() int64 {
@@ -825,20 +1539,172 @@ type SendWebsocketMessageRequest struct {
 func (x *SendWebsocketMessageRequest) Reset() {
 	*x = SendWebsocketMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[10]
+		mi := &file_sandwich_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendWebsocketMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendWebsocketMessageRequest) ProtoMessage() {}
+
+func (x *SendWebsocketMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendWebsocketMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendWebsocketMessageRequest) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SendWebsocketMessageRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *SendWebsocketMessageRequest) GetShard() int32 {
+	if x != nil {
+		return x.Shard
+	}
+	return 0
+}
+
+func (x *SendWebsocketMessageRequest) GetGatewayOpCode() int32 {
+	if x != nil {
+		return x.GatewayOpCode
+	}
+	return 0
+}
+
+func (x *SendWebsocketMessageRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DiscordRESTRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIdentifier string `protobuf:"bytes,1,opt,name=application_identifier,json=applicationIdentifier,proto3" json:"application_identifier,omitempty"`
+	Method                string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// path is relative to the API version, such as /channels/123/messages.
+	Path    string            `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Body    []byte            `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Headers map[string]string `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DiscordRESTRequest) Reset() {
+	*x = DiscordRESTRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscordRESTRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscordRESTRequest) ProtoMessage() {}
+
+func (x *DiscordRESTRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscordRESTRequest.ProtoReflect.Descriptor instead.
+func (*DiscordRESTRequest) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DiscordRESTRequest) GetApplicationIdentifier() string {
+	if x != nil {
+		return x.ApplicationIdentifier
+	}
+	return ""
+}
+
+func (x *DiscordRESTRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *DiscordRESTRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DiscordRESTRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *DiscordRESTRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// DiscordRESTResponse is the response from Discord. Responses with an error status are still ok.
+type DiscordRESTResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResponse *BaseResponse     `protobuf:"bytes,1,opt,name=base_response,json=baseResponse,proto3" json:"base_response,omitempty"`
+	StatusCode   int32             `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Headers      map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body         []byte            `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *DiscordRESTResponse) Reset() {
+	*x = DiscordRESTResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendWebsocketMessageRequest) String() string {
+func (x *DiscordRESTResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendWebsocketMessageRequest) ProtoMessage() {}
+func (*DiscordRESTResponse) ProtoMessage() {}
 
-func (x *SendWebsocketMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[10]
+func (x *DiscordRESTResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,35 +1715,35 @@ func (x *SendWebsocketMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendWebsocketMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendWebsocketMessageRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DiscordRESTResponse.ProtoReflect.Descriptor instead.
+func (*DiscordRESTResponse) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *SendWebsocketMessageRequest) GetIdentifier() string {
+func (x *DiscordRESTResponse) GetBaseResponse() *BaseResponse {
 	if x != nil {
-		return x.Identifier
+		return x.BaseResponse
 	}
-	return ""
+	return nil
 }
 
-func (x *SendWebsocketMessageRequest) GetShard() int32 {
+func (x *DiscordRESTResponse) GetStatusCode() int32 {
 	if x != nil {
-		return x.Shard
+		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *SendWebsocketMessageRequest) GetGatewayOpCode() int32 {
+func (x *DiscordRESTResponse) GetHeaders() map[string]string {
 	if x != nil {
-		return x.GatewayOpCode
+		return x.Headers
 	}
-	return 0
+	return nil
 }
 
-func (x *SendWebsocketMessageRequest) GetData() []byte {
+func (x *DiscordRESTResponse) GetBody() []byte {
 	if x != nil {
-		return x.Data
+		return x.Body
 	}
 	return nil
 }
@@ -895,7 +1761,7 @@ type RelayMessageRequest struct {
 func (x *RelayMessageRequest) Reset() {
 	*x = RelayMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[11]
+		mi := &file_sandwich_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -908,7 +1774,7 @@ func (x *RelayMessageRequest) String() string {
 func (*RelayMessageRequest) ProtoMessage() {}
 
 func (x *RelayMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[11]
+	mi := &file_sandwich_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,7 +1787,7 @@ func (x *RelayMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelayMessageRequest.ProtoReflect.Descriptor instead.
 func (*RelayMessageRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{11}
+	return file_sandwich_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RelayMessageRequest) GetIdentifier() string {
@@ -956,7 +1822,7 @@ type WhereIsGuildRequest struct {
 func (x *WhereIsGuildRequest) Reset() {
 	*x = WhereIsGuildRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[12]
+		mi := &file_sandwich_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -969,7 +1835,7 @@ func (x *WhereIsGuildRequest) String() string {
 func (*WhereIsGuildRequest) ProtoMessage() {}
 
 func (x *WhereIsGuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[12]
+	mi := &file_sandwich_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,7 +1848,7 @@ func (x *WhereIsGuildRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhereIsGuildRequest.ProtoReflect.Descriptor instead.
 func (*WhereIsGuildRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{12}
+	return file_sandwich_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *WhereIsGuildRequest) GetGuildId() int64 {
@@ -1004,7 +1870,7 @@ type WhereIsGuildResponse struct {
 func (x *WhereIsGuildResponse) Reset() {
 	*x = WhereIsGuildResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[13]
+		mi := &file_sandwich_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1017,7 +1883,7 @@ func (x *WhereIsGuildResponse) String() string {
 func (*WhereIsGuildResponse) ProtoMessage() {}
 
 func (x *WhereIsGuildResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[13]
+	mi := &file_sandwich_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,7 +1896,7 @@ func (x *WhereIsGuildResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhereIsGuildResponse.ProtoReflect.Descriptor instead.
 func (*WhereIsGuildResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{13}
+	return file_sandwich_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *WhereIsGuildResponse) GetBaseResponse() *BaseResponse {
@@ -1060,7 +1926,7 @@ type WhereIsGuildLocation struct {
 func (x *WhereIsGuildLocation) Reset() {
 	*x = WhereIsGuildLocation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[14]
+		mi := &file_sandwich_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1073,7 +1939,7 @@ func (x *WhereIsGuildLocation) String() string {
 func (*WhereIsGuildLocation) ProtoMessage() {}
 
 func (x *WhereIsGuildLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[14]
+	mi := &file_sandwich_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,7 +1952,7 @@ func (x *WhereIsGuildLocation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhereIsGuildLocation.ProtoReflect.Descriptor instead.
 func (*WhereIsGuildLocation) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{14}
+	return file_sandwich_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *WhereIsGuildLocation) GetIdentifier() string {
@@ -1121,7 +1987,7 @@ type FetchGuildRequest struct {
 func (x *FetchGuildRequest) Reset() {
 	*x = FetchGuildRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[15]
+		mi := &file_sandwich_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1134,7 +2000,7 @@ func (x *FetchGuildRequest) String() string {
 func (*FetchGuildRequest) ProtoMessage() {}
 
 func (x *FetchGuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[15]
+	mi := &file_sandwich_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +2013,7 @@ func (x *FetchGuildRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildRequest.ProtoReflect.Descriptor instead.
 func (*FetchGuildRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{15}
+	return file_sandwich_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *FetchGuildRequest) GetGuildIds() []int64 {
@@ -1169,7 +2035,7 @@ type FetchGuildResponse struct {
 func (x *FetchGuildResponse) Reset() {
 	*x = FetchGuildResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[16]
+		mi := &file_sandwich_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1182,7 +2048,7 @@ func (x *FetchGuildResponse) String() string {
 func (*FetchGuildResponse) ProtoMessage() {}
 
 func (x *FetchGuildResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[16]
+	mi := &file_sandwich_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,7 +2061,7 @@ func (x *FetchGuildResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildResponse.ProtoReflect.Descriptor instead.
 func (*FetchGuildResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{16}
+	return file_sandwich_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *FetchGuildResponse) GetBaseResponse() *BaseResponse {
@@ -1224,7 +2090,7 @@ type FetchGuildMemberRequest struct {
 func (x *FetchGuildMemberRequest) Reset() {
 	*x = FetchGuildMemberRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[17]
+		mi := &file_sandwich_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1237,7 +2103,7 @@ func (x *FetchGuildMemberRequest) String() string {
 func (*FetchGuildMemberRequest) ProtoMessage() {}
 
 func (x *FetchGuildMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[17]
+	mi := &file_sandwich_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1250,7 +2116,7 @@ func (x *FetchGuildMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildMemberRequest.ProtoReflect.Descriptor instead.
 func (*FetchGuildMemberRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{17}
+	return file_sandwich_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *FetchGuildMemberRequest) GetGuildId() int64 {
@@ -1279,7 +2145,7 @@ type FetchGuildMemberResponse struct {
 func (x *FetchGuildMemberResponse) Reset() {
 	*x = FetchGuildMemberResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[18]
+		mi := &file_sandwich_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1292,7 +2158,7 @@ func (x *FetchGuildMemberResponse) String() string {
 func (*FetchGuildMemberResponse) ProtoMessage() {}
 
 func (x *FetchGuildMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[18]
+	mi := &file_sandwich_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1305,7 +2171,7 @@ func (x *FetchGuildMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildMemberResponse.ProtoReflect.Descriptor instead.
 func (*FetchGuildMemberResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{18}
+	return file_sandwich_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *FetchGuildMemberResponse) GetBaseResponse() *BaseResponse {
@@ -1334,7 +2200,7 @@ type FetchGuildChannelRequest struct {
 func (x *FetchGuildChannelRequest) Reset() {
 	*x = FetchGuildChannelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[19]
+		mi := &file_sandwich_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1347,7 +2213,7 @@ func (x *FetchGuildChannelRequest) String() string {
 func (*FetchGuildChannelRequest) ProtoMessage() {}
 
 func (x *FetchGuildChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[19]
+	mi := &file_sandwich_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +2226,7 @@ func (x *FetchGuildChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildChannelRequest.ProtoReflect.Descriptor instead.
 func (*FetchGuildChannelRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{19}
+	return file_sandwich_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *FetchGuildChannelRequest) GetGuildId() int64 {
@@ -1389,7 +2255,7 @@ type FetchGuildChannelResponse struct {
 func (x *FetchGuildChannelResponse) Reset() {
 	*x = FetchGuildChannelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[20]
+		mi := &file_sandwich_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1402,7 +2268,7 @@ func (x *FetchGuildChannelResponse) String() string {
 func (*FetchGuildChannelResponse) ProtoMessage() {}
 
 func (x *FetchGuildChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[20]
+	mi := &file_sandwich_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1415,7 +2281,7 @@ func (x *FetchGuildChannelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildChannelResponse.ProtoReflect.Descriptor instead.
 func (*FetchGuildChannelResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{20}
+	return file_sandwich_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *FetchGuildChannelResponse) GetBaseResponse() *BaseResponse {
@@ -1444,7 +2310,7 @@ type FetchGuildRoleRequest struct {
 func (x *FetchGuildRoleRequest) Reset() {
 	*x = FetchGuildRoleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[21]
+		mi := &file_sandwich_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1457,7 +2323,7 @@ func (x *FetchGuildRoleRequest) String() string {
 func (*FetchGuildRoleRequest) ProtoMessage() {}
 
 func (x *FetchGuildRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[21]
+	mi := &file_sandwich_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1470,7 +2336,7 @@ func (x *FetchGuildRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildRoleRequest.ProtoReflect.Descriptor instead.
 func (*FetchGuildRoleRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{21}
+	return file_sandwich_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *FetchGuildRoleRequest) GetGuildId() int64 {
@@ -1499,7 +2365,7 @@ type FetchGuildRoleResponse struct {
 func (x *FetchGuildRoleResponse) Reset() {
 	*x = FetchGuildRoleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[22]
+		mi := &file_sandwich_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1512,7 +2378,7 @@ func (x *FetchGuildRoleResponse) String() string {
 func (*FetchGuildRoleResponse) ProtoMessage() {}
 
 func (x *FetchGuildRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[22]
+	mi := &file_sandwich_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1525,7 +2391,7 @@ func (x *FetchGuildRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildRoleResponse.ProtoReflect.Descriptor instead.
 func (*FetchGuildRoleResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{22}
+	return file_sandwich_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *FetchGuildRoleResponse) GetBaseResponse() *BaseResponse {
@@ -1554,7 +2420,7 @@ type FetchGuildEmojiRequest struct {
 func (x *FetchGuildEmojiRequest) Reset() {
 	*x = FetchGuildEmojiRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[23]
+		mi := &file_sandwich_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1567,7 +2433,7 @@ func (x *FetchGuildEmojiRequest) String() string {
 func (*FetchGuildEmojiRequest) ProtoMessage() {}
 
 func (x *FetchGuildEmojiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[23]
+	mi := &file_sandwich_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1580,7 +2446,7 @@ func (x *FetchGuildEmojiRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildEmojiRequest.ProtoReflect.Descriptor instead.
 func (*FetchGuildEmojiRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{23}
+	return file_sandwich_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *FetchGuildEmojiRequest) GetGuildId() int64 {
@@ -1609,7 +2475,7 @@ type FetchGuildEmojiResponse struct {
 func (x *FetchGuildEmojiResponse) Reset() {
 	*x = FetchGuildEmojiResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[24]
+		mi := &file_sandwich_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1622,7 +2488,7 @@ func (x *FetchGuildEmojiResponse) String() string {
 func (*FetchGuildEmojiResponse) ProtoMessage() {}
 
 func (x *FetchGuildEmojiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[24]
+	mi := &file_sandwich_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +2501,7 @@ func (x *FetchGuildEmojiResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildEmojiResponse.ProtoReflect.Descriptor instead.
 func (*FetchGuildEmojiResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{24}
+	return file_sandwich_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *FetchGuildEmojiResponse) GetBaseResponse() *BaseResponse {
@@ -1664,7 +2530,7 @@ type FetchGuildStickerRequest struct {
 func (x *FetchGuildStickerRequest) Reset() {
 	*x = FetchGuildStickerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[25]
+		mi := &file_sandwich_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1677,7 +2543,7 @@ func (x *FetchGuildStickerRequest) String() string {
 func (*FetchGuildStickerRequest) ProtoMessage() {}
 
 func (x *FetchGuildStickerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[25]
+	mi := &file_sandwich_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1690,7 +2556,7 @@ func (x *FetchGuildStickerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildStickerRequest.ProtoReflect.Descriptor instead.
 func (*FetchGuildStickerRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{25}
+	return file_sandwich_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *FetchGuildStickerRequest) GetGuildId() int64 {
@@ -1719,7 +2585,7 @@ type FetchGuildStickerResponse struct {
 func (x *FetchGuildStickerResponse) Reset() {
 	*x = FetchGuildStickerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[26]
+		mi := &file_sandwich_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1732,7 +2598,7 @@ func (x *FetchGuildStickerResponse) String() string {
 func (*FetchGuildStickerResponse) ProtoMessage() {}
 
 func (x *FetchGuildStickerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[26]
+	mi := &file_sandwich_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1745,7 +2611,7 @@ func (x *FetchGuildStickerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildStickerResponse.ProtoReflect.Descriptor instead.
 func (*FetchGuildStickerResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{26}
+	return file_sandwich_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *FetchGuildStickerResponse) GetBaseResponse() *BaseResponse {
@@ -1774,7 +2640,7 @@ type FetchGuildVoiceStateRequest struct {
 func (x *FetchGuildVoiceStateRequest) Reset() {
 	*x = FetchGuildVoiceStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[27]
+		mi := &file_sandwich_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1787,7 +2653,7 @@ func (x *FetchGuildVoiceStateRequest) String() string {
 func (*FetchGuildVoiceStateRequest) ProtoMessage() {}
 
 func (x *FetchGuildVoiceStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[27]
+	mi := &file_sandwich_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1800,7 +2666,7 @@ func (x *FetchGuildVoiceStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildVoiceStateRequest.ProtoReflect.Descriptor instead.
 func (*FetchGuildVoiceStateRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{27}
+	return file_sandwich_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *FetchGuildVoiceStateRequest) GetGuildId() int64 {
@@ -1829,7 +2695,7 @@ type FetchGuildVoiceStateResponse struct {
 func (x *FetchGuildVoiceStateResponse) Reset() {
 	*x = FetchGuildVoiceStateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[28]
+		mi := &file_sandwich_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1842,7 +2708,7 @@ func (x *FetchGuildVoiceStateResponse) String() string {
 func (*FetchGuildVoiceStateResponse) ProtoMessage() {}
 
 func (x *FetchGuildVoiceStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[28]
+	mi := &file_sandwich_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1855,7 +2721,7 @@ func (x *FetchGuildVoiceStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildVoiceStateResponse.ProtoReflect.Descriptor instead.
 func (*FetchGuildVoiceStateResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{28}
+	return file_sandwich_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *FetchGuildVoiceStateResponse) GetBaseResponse() *BaseResponse {
@@ -1883,7 +2749,7 @@ type FetchUserRequest struct {
 func (x *FetchUserRequest) Reset() {
 	*x = FetchUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[29]
+		mi := &file_sandwich_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1896,7 +2762,7 @@ func (x *FetchUserRequest) String() string {
 func (*FetchUserRequest) ProtoMessage() {}
 
 func (x *FetchUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[29]
+	mi := &file_sandwich_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1909,7 +2775,7 @@ func (x *FetchUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchUserRequest.ProtoReflect.Descriptor instead.
 func (*FetchUserRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{29}
+	return file_sandwich_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *FetchUserRequest) GetUserIds() []int64 {
@@ -1931,7 +2797,7 @@ type FetchUserResponse struct {
 func (x *FetchUserResponse) Reset() {
 	*x = FetchUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[30]
+		mi := &file_sandwich_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1944,7 +2810,7 @@ func (x *FetchUserResponse) String() string {
 func (*FetchUserResponse) ProtoMessage() {}
 
 func (x *FetchUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[30]
+	mi := &file_sandwich_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1957,7 +2823,7 @@ func (x *FetchUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchUserResponse.ProtoReflect.Descriptor instead.
 func (*FetchUserResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{30}
+	return file_sandwich_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *FetchUserResponse) GetBaseResponse() *BaseResponse {
@@ -1985,7 +2851,7 @@ type FetchUserMutualGuildsRequest struct {
 func (x *FetchUserMutualGuildsRequest) Reset() {
 	*x = FetchUserMutualGuildsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[31]
+		mi := &file_sandwich_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1998,7 +2864,7 @@ func (x *FetchUserMutualGuildsRequest) String() string {
 func (*FetchUserMutualGuildsRequest) ProtoMessage() {}
 
 func (x *FetchUserMutualGuildsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[31]
+	mi := &file_sandwich_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2011,7 +2877,7 @@ func (x *FetchUserMutualGuildsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchUserMutualGuildsRequest.ProtoReflect.Descriptor instead.
 func (*FetchUserMutualGuildsRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{31}
+	return file_sandwich_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *FetchUserMutualGuildsRequest) GetUserId() int64 {
@@ -2033,7 +2899,7 @@ type FetchUserMutualGuildsResponse struct {
 func (x *FetchUserMutualGuildsResponse) Reset() {
 	*x = FetchUserMutualGuildsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[32]
+		mi := &file_sandwich_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2046,7 +2912,7 @@ func (x *FetchUserMutualGuildsResponse) String() string {
 func (*FetchUserMutualGuildsResponse) ProtoMessage() {}
 
 func (x *FetchUserMutualGuildsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[32]
+	mi := &file_sandwich_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2059,7 +2925,7 @@ func (x *FetchUserMutualGuildsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchUserMutualGuildsResponse.ProtoReflect.Descriptor instead.
 func (*FetchUserMutualGuildsResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{32}
+	return file_sandwich_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *FetchUserMutualGuildsResponse) GetBaseResponse() *BaseResponse {
@@ -2087,7 +2953,7 @@ type FetchGuildIDsRequest struct {
 func (x *FetchGuildIDsRequest) Reset() {
 	*x = FetchGuildIDsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[33]
+		mi := &file_sandwich_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2100,7 +2966,7 @@ func (x *FetchGuildIDsRequest) String() string {
 func (*FetchGuildIDsRequest) ProtoMessage() {}
 
 func (x *FetchGuildIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[33]
+	mi := &file_sandwich_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2113,7 +2979,7 @@ func (x *FetchGuildIDsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildIDsRequest.ProtoReflect.Descriptor instead.
 func (*FetchGuildIDsRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{33}
+	return file_sandwich_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *FetchGuildIDsRequest) GetIdentifier() string {
@@ -2135,7 +3001,7 @@ type FetchGuildIDsResponse struct {
 func (x *FetchGuildIDsResponse) Reset() {
 	*x = FetchGuildIDsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[34]
+		mi := &file_sandwich_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2148,7 +3014,7 @@ func (x *FetchGuildIDsResponse) String() string {
 func (*FetchGuildIDsResponse) ProtoMessage() {}
 
 func (x *FetchGuildIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[34]
+	mi := &file_sandwich_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2161,7 +3027,7 @@ func (x *FetchGuildIDsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchGuildIDsResponse.ProtoReflect.Descriptor instead.
 func (*FetchGuildIDsResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{34}
+	return file_sandwich_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *FetchGuildIDsResponse) GetBaseResponse() *BaseResponse {
@@ -2189,7 +3055,7 @@ type FetchVoiceStatesRequest struct {
 func (x *FetchVoiceStatesRequest) Reset() {
 	*x = FetchVoiceStatesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[35]
+		mi := &file_sandwich_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2202,7 +3068,7 @@ func (x *FetchVoiceStatesRequest) String() string {
 func (*FetchVoiceStatesRequest) ProtoMessage() {}
 
 func (x *FetchVoiceStatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[35]
+	mi := &file_sandwich_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2215,7 +3081,7 @@ func (x *FetchVoiceStatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchVoiceStatesRequest.ProtoReflect.Descriptor instead.
 func (*FetchVoiceStatesRequest) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{35}
+	return file_sandwich_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *FetchVoiceStatesRequest) GetGuildIds() []int64 {
@@ -2237,7 +3103,7 @@ type FetchVoiceStatesResponse struct {
 func (x *FetchVoiceStatesResponse) Reset() {
 	*x = FetchVoiceStatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sandwich_proto_msgTypes[36]
+		mi := &file_sandwich_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2250,7 +3116,7 @@ func (x *FetchVoiceStatesResponse) String() string {
 func (*FetchVoiceStatesResponse) ProtoMessage() {}
 
 func (x *FetchVoiceStatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sandwich_proto_msgTypes[36]
+	mi := &file_sandwich_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2263,7 +3129,7 @@ func (x *FetchVoiceStatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchVoiceStatesResponse.ProtoReflect.Descriptor instead.
 func (*FetchVoiceStatesResponse) Descriptor() ([]byte, []int) {
-	return file_sandwich_proto_rawDescGZIP(), []int{36}
+	return file_sandwich_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *FetchVoiceStatesResponse) GetBaseResponse() *BaseResponse {
@@ -2280,6 +3146,177 @@ func (x *FetchVoiceStatesResponse) GetVoiceStates() map[int64]*VoiceState {
 	return nil
 }
 
+// ProducedPayload is an event produced to consumers, when producers use the protobuf serializer.
+type ProducedPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op int32 `protobuf:"varint,1,opt,name=op,proto3" json:"op,omitempty"`
+	// Data is the JSON encoded event data.
+	Data     []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Sequence int32  `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type     string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// Extra contains JSON encoded values added by the dispatch handler.
+	Extra    map[string][]byte `protobuf:"bytes,5,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Metadata *ProducedMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Trace contains the unix time in nanoseconds of each step the event went through.
+	Trace map[string]int64 `protobuf:"bytes,7,rep,name=trace,proto3" json:"trace,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ProducedPayload) Reset() {
+	*x = ProducedPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProducedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProducedPayload) ProtoMessage() {}
+
+func (x *ProducedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProducedPayload.ProtoReflect.Descriptor instead.
+func (*ProducedPayload) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ProducedPayload) GetOp() int32 {
+	if x != nil {
+		return x.Op
+	}
+	return 0
+}
+
+func (x *ProducedPayload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ProducedPayload) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ProducedPayload) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProducedPayload) GetExtra() map[string][]byte {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *ProducedPayload) GetMetadata() *ProducedMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ProducedPayload) GetTrace() map[string]int64 {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+type ProducedMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifier    string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Application   string `protobuf:"bytes,2,opt,name=application,proto3" json:"application,omitempty"`
+	ApplicationId int64  `protobuf:"varint,3,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// Shard is the shard group, shard ID and shard count the event was received on.
+	Shard []int32 `protobuf:"varint,4,rep,packed,name=shard,proto3" json:"shard,omitempty"`
+}
+
+func (x *ProducedMetadata) Reset() {
+	*x = ProducedMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sandwich_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProducedMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProducedMetadata) ProtoMessage() {}
+
+func (x *ProducedMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_sandwich_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProducedMetadata.ProtoReflect.Descriptor instead.
+func (*ProducedMetadata) Descriptor() ([]byte, []int) {
+	return file_sandwich_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ProducedMetadata) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *ProducedMetadata) GetApplication() string {
+	if x != nil {
+		return x.Application
+	}
+	return ""
+}
+
+func (x *ProducedMetadata) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *ProducedMetadata) GetShard() []int32 {
+	if x != nil {
+		return x.Shard
+	}
+	return nil
+}
+
 var File_sandwich_proto protoreflect.FileDescriptor
 
 var file_sandwich_proto_rawDesc = []byte{
@@ -2293,152 +3330,277 @@ var file_sandwich_proto_rawDesc = []byte{
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2f, 0x0a, 0x0d,
 	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
 	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x42, 0x0a,
+	0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x78, 0x0a,
 	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a,
 	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x4e, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x70,
+	0x61, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x38, 0x0a, 0x1d, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x44, 0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92,
+	0x01, 0x0a, 0x1d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x63, 0x68, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0c, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x22, 0x44, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x73, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x31, 0x0a, 0x15, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x16, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x22, 0x76, 0x0a, 0x21, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x7c, 0x0a, 0x17, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x42, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x1d, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x70,
 	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
 	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x70, 0x70, 0x6c,
 	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x22, 0x76, 0x0a, 0x21, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1a, 0x0a,
-	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x91, 0x02, 0x0a, 0x18, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x73, 0x61, 0x6e, 0x64,
-	0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x5e, 0x0a,
-	0x11, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x53,
-	0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9e, 0x05,
-	0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61,
-	0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x73, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x8c, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x91,
+	0x02, 0x0a, 0x18, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x5e, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x63, 0x68, 0x2e, 0x53, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x9e, 0x05, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x35, 0x0a, 0x16, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x73,
+	0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64,
+	0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x4f, 0x6e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x68, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x22, 0xf8, 0x03, 0x0a, 0x13, 0x53, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x16, 0x61,
 	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x70, 0x70,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x70, 0x70,
 	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
 	0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x12, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
 	0x69, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
-	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x62,
-	0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f,
-	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75,
-	0x74, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x15,
-	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x5f,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x4f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
-	0x27, 0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42,
-	0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0e, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x42, 0x6c, 0x61, 0x63,
-	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x68,
-	0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74,
-	0x6f, 0x53, 0x68, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72,
-	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73,
-	0x68, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x61,
-	0x72, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68,
-	0x61, 0x72, 0x64, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xf8,
-	0x03, 0x0a, 0x13, 0x53, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2f, 0x0a,
-	0x13, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x21,
-	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x68, 0x61, 0x72, 0x64,
-	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x68,
-	0x61, 0x72, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x61, 0x6e,
-	0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x53, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x4a, 0x0a,
-	0x0b, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd9, 0x02, 0x0a, 0x05, 0x53, 0x68,
-	0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x7a,
-	0x79, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x6c, 0x61, 0x7a, 0x79, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x75,
-	0x69, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x67, 0x75, 0x69, 0x6c,
-	0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2e,
-	0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
-	0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x73,
-	0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2c,
-	0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
-	0x5f, 0x61, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74,
-	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x27, 0x0a, 0x0f,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4c, 0x61,
-	0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x58, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22,
-	0x8f, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x73, 0x68, 0x61, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x5f, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x5d, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x41, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x53, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x64, 0x73, 0x1a, 0x4a, 0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x53, 0x68,
+	0x61, 0x72, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd9,
+	0x02, 0x0a, 0x05, 0x53, 0x68, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x75, 0x6e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x61, 0x7a, 0x79, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x61, 0x7a, 0x79, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x53,
+	0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x41, 0x63,
+	0x6b, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x58, 0x0a, 0x18, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x5f, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x22, 0x8f, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x65, 0x62,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x70, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8c, 0x02, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x45, 0x53, 0x54, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x16, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x43, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68,
+	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x45, 0x53, 0x54, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x02, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x45, 0x53, 0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x45, 0x53, 0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x5d, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
 	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64,
 	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
@@ -2681,7 +3843,41 @@ var file_sandwich_proto_rawDesc = []byte{
 	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x56,
 	0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x32, 0xfe, 0x0e, 0x0a, 0x08, 0x53, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x03, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x3a, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x1a, 0x38, 0x0a, 0x0a,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x91, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x64, 0x32, 0x98, 0x14, 0x0a, 0x08, 0x53, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63,
 	0x68, 0x12, 0x3f, 0x0a, 0x06, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x17, 0x2e, 0x73, 0x61,
 	0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e,
@@ -2695,117 +3891,159 @@ var file_sandwich_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x73, 0x61, 0x6e,
 	0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77,
-	0x69, 0x63, 0x68, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x1a, 0x22, 0x2e, 0x73, 0x61, 0x6e, 0x64,
-	0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27,
+	0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x63, 0x68, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x73,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x59, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67,
-	0x1a, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x74,
-	0x6f, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e,
-	0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x57, 0x69,
-	0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x1a, 0x16, 0x2e, 0x73, 0x61, 0x6e,
-	0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x73, 0x61, 0x6e, 0x64,
-	0x77, 0x69, 0x63, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x53, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63,
-	0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4e,
-	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e,
-	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
-	0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68,
-	0x75, 0x6e, 0x6b, 0x12, 0x22, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69,
-	0x63, 0x68, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x57, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x73, 0x61, 0x6e, 0x64,
-	0x77, 0x69, 0x63, 0x68, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x57, 0x68,
-	0x65, 0x72, 0x65, 0x49, 0x73, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x73, 0x61, 0x6e,
-	0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x49, 0x73, 0x47, 0x75, 0x69,
-	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x61, 0x6e, 0x64,
-	0x77, 0x69, 0x63, 0x68, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x49, 0x73, 0x47, 0x75, 0x69, 0x6c,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x10, 0x46,
-	0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x73, 0x12,
-	0x1e, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64,
-	0x12, 0x1b, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63,
-	0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75,
-	0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a,
-	0x10, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x21, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e,
-	0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
-	0x22, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46,
-	0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x73,
-	0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69,
-	0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x54, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e,
+	0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x63, 0x68, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x63, 0x68, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x59, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x1a, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f,
+	0x53, 0x74, 0x6f, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2b, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x57, 0x69, 0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x1a, 0x16, 0x2e, 0x73,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x73, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x53, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63,
+	0x68, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5b, 0x0a, 0x16, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x2e, 0x73, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a,
+	0x11, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x1a, 0x23, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x11, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x22, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x14, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x45, 0x53, 0x54, 0x12, 0x1c, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68,
+	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x45, 0x53, 0x54, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x45, 0x53, 0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x57, 0x68, 0x65, 0x72, 0x65, 0x49, 0x73, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e,
+	0x57, 0x68, 0x65, 0x72, 0x65, 0x49, 0x73, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x57,
+	0x68, 0x65, 0x72, 0x65, 0x49, 0x73, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x10, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c,
+	0x6c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x61, 0x6e, 0x64,
+	0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x61, 0x6e, 0x64,
+	0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1b, 0x2e, 0x73, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x73, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x22, 0x2e, 0x73, 0x61, 0x6e, 0x64,
+	0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
 	0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75,
-	0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x58, 0x0a, 0x0f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x45,
-	0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x20, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e,
-	0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63,
-	0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x6d, 0x6f, 0x6a,
-	0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x46,
-	0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
-	0x12, 0x22, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63,
-	0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e,
-	0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x14, 0x46,
-	0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46,
-	0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x61, 0x6e,
+	0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63,
+	0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x20,
+	0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x61, 0x6e,
 	0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64,
-	0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x46, 0x65, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1a, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x15,
-	0x46, 0x65, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x47,
-	0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68,
-	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c,
-	0x47, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
-	0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x55, 0x73,
-	0x65, 0x72, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x46, 0x65, 0x74, 0x63,
-	0x68, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x73,
-	0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x69,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x57, 0x65, 0x6c, 0x63, 0x6f, 0x6d, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d,
-	0x2f, 0x53, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2d, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x14, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x2e,
+	0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x09, 0x46, 0x65, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x73, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x15, 0x46, 0x65, 0x74, 0x63, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12,
+	0x26, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x75, 0x74, 0x75,
+	0x61, 0x6c, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x46, 0x65, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63,
+	0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x61, 0x6e, 0x64,
+	0x77, 0x69, 0x63, 0x68, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x57, 0x65,
+	0x6c, 0x63, 0x6f, 0x6d, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x2f, 0x53, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x63, 0x68, 0x2d, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x73, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x63, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2820,158 +4058,205 @@ func file_sandwich_proto_rawDescGZIP() []byte {
 	return file_sandwich_proto_rawDescData
 }
 
-var file_sandwich_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_sandwich_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_sandwich_proto_goTypes = []interface{}{
 	(*BaseResponse)(nil),                      // 0: sandwich.BaseResponse
 	(*ListenRequest)(nil),                     // 1: sandwich.ListenRequest
 	(*ListenResponse)(nil),                    // 2: sandwich.ListenResponse
-	(*ApplicationIdentifier)(nil),             // 3: sandwich.ApplicationIdentifier
-	(*ApplicationIdentifierWithBlocking)(nil), // 4: sandwich.ApplicationIdentifierWithBlocking
-	(*FetchApplicationResponse)(nil),          // 5: sandwich.FetchApplicationResponse
-	(*CreateApplicationRequest)(nil),          // 6: sandwich.CreateApplicationRequest
-	(*SandwichApplication)(nil),               // 7: sandwich.SandwichApplication
-	(*Shard)(nil),                             // 8: sandwich.Shard
-	(*RequestGuildChunkRequest)(nil),          // 9: sandwich.RequestGuildChunkRequest
-	(*SendWebsocketMessageRequest)(nil),       // 10: sandwich.SendWebsocketMessageRequest
-	(*RelayMessageRequest)(nil),               // 11: sandwich.RelayMessageRequest
-	(*WhereIsGuildRequest)(nil),               // 12: sandwich.WhereIsGuildRequest
-	(*WhereIsGuildResponse)(nil),              // 13: sandwich.WhereIsGuildResponse
-	(*WhereIsGuildLocation)(nil),              // 14: sandwich.WhereIsGuildLocation
-	(*FetchGuildRequest)(nil),                 // 15: sandwich.FetchGuildRequest
-	(*FetchGuildResponse)(nil),                // 16: sandwich.FetchGuildResponse
-	(*FetchGuildMemberRequest)(nil),           // 17: sandwich.FetchGuildMemberRequest
-	(*FetchGuildMemberResponse)(nil),          // 18: sandwich.FetchGuildMemberResponse
-	(*FetchGuildChannelRequest)(nil),          // 19: sandwich.FetchGuildChannelRequest
-	(*FetchGuildChannelResponse)(nil),         // 20: sandwich.FetchGuildChannelResponse
-	(*FetchGuildRoleRequest)(nil),             // 21: sandwich.FetchGuildRoleRequest
-	(*FetchGuildRoleResponse)(nil),            // 22: sandwich.FetchGuildRoleResponse
-	(*FetchGuildEmojiRequest)(nil),            // 23: sandwich.FetchGuildEmojiRequest
-	(*FetchGuildEmojiResponse)(nil),           // 24: sandwich.FetchGuildEmojiResponse
-	(*FetchGuildStickerRequest)(nil),          // 25: sandwich.FetchGuildStickerRequest
-	(*FetchGuildStickerResponse)(nil),         // 26: sandwich.FetchGuildStickerResponse
-	(*FetchGuildVoiceStateRequest)(nil),       // 27: sandwich.FetchGuildVoiceStateRequest
-	(*FetchGuildVoiceStateResponse)(nil),      // 28: sandwich.FetchGuildVoiceStateResponse
-	(*FetchUserRequest)(nil),                  // 29: sandwich.FetchUserRequest
-	(*FetchUserResponse)(nil),                 // 30: sandwich.FetchUserResponse
-	(*FetchUserMutualGuildsRequest)(nil),      // 31: sandwich.FetchUserMutualGuildsRequest
-	(*FetchUserMutualGuildsResponse)(nil),     // 32: sandwich.FetchUserMutualGuildsResponse
-	(*FetchGuildIDsRequest)(nil),              // 33: sandwich.FetchGuildIDsRequest
-	(*FetchGuildIDsResponse)(nil),             // 34: sandwich.FetchGuildIDsResponse
-	(*FetchVoiceStatesRequest)(nil),           // 35: sandwich.FetchVoiceStatesRequest
-	(*FetchVoiceStatesResponse)(nil),          // 36: sandwich.FetchVoiceStatesResponse
-	nil,                                       // 37: sandwich.FetchApplicationResponse.ApplicationsEntry
-	nil,                                       // 38: sandwich.SandwichApplication.ShardsEntry
-	nil,                                       // 39: sandwich.WhereIsGuildResponse.LocationsEntry
-	nil,                                       // 40: sandwich.FetchGuildResponse.GuildsEntry
-	nil,                                       // 41: sandwich.FetchGuildMemberResponse.GuildMembersEntry
-	nil,                                       // 42: sandwich.FetchGuildChannelResponse.ChannelsEntry
-	nil,                                       // 43: sandwich.FetchGuildRoleResponse.RolesEntry
-	nil,                                       // 44: sandwich.FetchGuildEmojiResponse.EmojisEntry
-	nil,                                       // 45: sandwich.FetchGuildStickerResponse.StickersEntry
-	nil,                                       // 46: sandwich.FetchGuildVoiceStateResponse.VoiceStatesEntry
-	nil,                                       // 47: sandwich.FetchUserResponse.UsersEntry
-	nil,                                       // 48: sandwich.FetchUserMutualGuildsResponse.GuildsEntry
-	nil,                                       // 49: sandwich.FetchVoiceStatesResponse.VoiceStatesEntry
-	(*GuildMember)(nil),                       // 50: sandwich.GuildMember
-	(*Guild)(nil),                             // 51: sandwich.Guild
-	(*Channel)(nil),                           // 52: sandwich.Channel
-	(*Role)(nil),                              // 53: sandwich.Role
-	(*Emoji)(nil),                             // 54: sandwich.Emoji
-	(*Sticker)(nil),                           // 55: sandwich.Sticker
-	(*VoiceState)(nil),                        // 56: sandwich.VoiceState
-	(*User)(nil),                              // 57: sandwich.User
-	(*emptypb.Empty)(nil),                     // 58: google.protobuf.Empty
+	(*ReconcileConfigurationRequest)(nil),     // 3: sandwich.ReconcileConfigurationRequest
+	(*ReconcileConfigurationResponse)(nil),    // 4: sandwich.ReconcileConfigurationResponse
+	(*ConfigurationChange)(nil),               // 5: sandwich.ConfigurationChange
+	(*ValidateConfigurationRequest)(nil),      // 6: sandwich.ValidateConfigurationRequest
+	(*ValidateConfigurationResponse)(nil),     // 7: sandwich.ValidateConfigurationResponse
+	(*ConfigurationError)(nil),                // 8: sandwich.ConfigurationError
+	(*ListConfigVersionsResponse)(nil),        // 9: sandwich.ListConfigVersionsResponse
+	(*ConfigVersion)(nil),                     // 10: sandwich.ConfigVersion
+	(*RollbackConfigRequest)(nil),             // 11: sandwich.RollbackConfigRequest
+	(*ApplicationIdentifier)(nil),             // 12: sandwich.ApplicationIdentifier
+	(*ApplicationIdentifierWithBlocking)(nil), // 13: sandwich.ApplicationIdentifierWithBlocking
+	(*FetchApplicationRequest)(nil),           // 14: sandwich.FetchApplicationRequest
+	(*RotateApplicationTokenRequest)(nil),     // 15: sandwich.RotateApplicationTokenRequest
+	(*ReplayDeadLettersResponse)(nil),         // 16: sandwich.ReplayDeadLettersResponse
+	(*FetchApplicationResponse)(nil),          // 17: sandwich.FetchApplicationResponse
+	(*CreateApplicationRequest)(nil),          // 18: sandwich.CreateApplicationRequest
+	(*SandwichApplication)(nil),               // 19: sandwich.SandwichApplication
+	(*Shard)(nil),                             // 20: sandwich.Shard
+	(*RequestGuildChunkRequest)(nil),          // 21: sandwich.RequestGuildChunkRequest
+	(*SendWebsocketMessageRequest)(nil),       // 22: sandwich.SendWebsocketMessageRequest
+	(*DiscordRESTRequest)(nil),                // 23: sandwich.DiscordRESTRequest
+	(*DiscordRESTResponse)(nil),               // 24: sandwich.DiscordRESTResponse
+	(*RelayMessageRequest)(nil),               // 25: sandwich.RelayMessageRequest
+	(*WhereIsGuildRequest)(nil),               // 26: sandwich.WhereIsGuildRequest
+	(*WhereIsGuildResponse)(nil),              // 27: sandwich.WhereIsGuildResponse
+	(*WhereIsGuildLocation)(nil),              // 28: sandwich.WhereIsGuildLocation
+	(*FetchGuildRequest)(nil),                 // 29: sandwich.FetchGuildRequest
+	(*FetchGuildResponse)(nil),                // 30: sandwich.FetchGuildResponse
+	(*FetchGuildMemberRequest)(nil),           // 31: sandwich.FetchGuildMemberRequest
+	(*FetchGuildMemberResponse)(nil),          // 32: sandwich.FetchGuildMemberResponse
+	(*FetchGuildChannelRequest)(nil),          // 33: sandwich.FetchGuildChannelRequest
+	(*FetchGuildChannelResponse)(nil),         // 34: sandwich.FetchGuildChannelResponse
+	(*FetchGuildRoleRequest)(nil),             // 35: sandwich.FetchGuildRoleRequest
+	(*FetchGuildRoleResponse)(nil),            // 36: sandwich.FetchGuildRoleResponse
+	(*FetchGuildEmojiRequest)(nil),            // 37: sandwich.FetchGuildEmojiRequest
+	(*FetchGuildEmojiResponse)(nil),           // 38: sandwich.FetchGuildEmojiResponse
+	(*FetchGuildStickerRequest)(nil),          // 39: sandwich.FetchGuildStickerRequest
+	(*FetchGuildStickerResponse)(nil),         // 40: sandwich.FetchGuildStickerResponse
+	(*FetchGuildVoiceStateRequest)(nil),       // 41: sandwich.FetchGuildVoiceStateRequest
+	(*FetchGuildVoiceStateResponse)(nil),      // 42: sandwich.FetchGuildVoiceStateResponse
+	(*FetchUserRequest)(nil),                  // 43: sandwich.FetchUserRequest
+	(*FetchUserResponse)(nil),                 // 44: sandwich.FetchUserResponse
+	(*FetchUserMutualGuildsRequest)(nil),      // 45: sandwich.FetchUserMutualGuildsRequest
+	(*FetchUserMutualGuildsResponse)(nil),     // 46: sandwich.FetchUserMutualGuildsResponse
+	(*FetchGuildIDsRequest)(nil),              // 47: sandwich.FetchGuildIDsRequest
+	(*FetchGuildIDsResponse)(nil),             // 48: sandwich.FetchGuildIDsResponse
+	(*FetchVoiceStatesRequest)(nil),           // 49: sandwich.FetchVoiceStatesRequest
+	(*FetchVoiceStatesResponse)(nil),          // 50: sandwich.FetchVoiceStatesResponse
+	(*ProducedPayload)(nil),                   // 51: sandwich.ProducedPayload
+	(*ProducedMetadata)(nil),                  // 52: sandwich.ProducedMetadata
+	nil,                                       // 53: sandwich.FetchApplicationResponse.ApplicationsEntry
+	nil,                                       // 54: sandwich.SandwichApplication.ShardsEntry
+	nil,                                       // 55: sandwich.DiscordRESTRequest.HeadersEntry
+	nil,                                       // 56: sandwich.DiscordRESTResponse.HeadersEntry
+	nil,                                       // 57: sandwich.WhereIsGuildResponse.LocationsEntry
+	nil,                                       // 58: sandwich.FetchGuildResponse.GuildsEntry
+	nil,                                       // 59: sandwich.FetchGuildMemberResponse.GuildMembersEntry
+	nil,                                       // 60: sandwich.FetchGuildChannelResponse.ChannelsEntry
+	nil,                                       // 61: sandwich.FetchGuildRoleResponse.RolesEntry
+	nil,                                       // 62: sandwich.FetchGuildEmojiResponse.EmojisEntry
+	nil,                                       // 63: sandwich.FetchGuildStickerResponse.StickersEntry
+	nil,                                       // 64: sandwich.FetchGuildVoiceStateResponse.VoiceStatesEntry
+	nil,                                       // 65: sandwich.FetchUserResponse.UsersEntry
+	nil,                                       // 66: sandwich.FetchUserMutualGuildsResponse.GuildsEntry
+	nil,                                       // 67: sandwich.FetchVoiceStatesResponse.VoiceStatesEntry
+	nil,                                       // 68: sandwich.ProducedPayload.ExtraEntry
+	nil,                                       // 69: sandwich.ProducedPayload.TraceEntry
+	(*GuildMember)(nil),                       // 70: sandwich.GuildMember
+	(*Guild)(nil),                             // 71: sandwich.Guild
+	(*Channel)(nil),                           // 72: sandwich.Channel
+	(*Role)(nil),                              // 73: sandwich.Role
+	(*Emoji)(nil),                             // 74: sandwich.Emoji
+	(*Sticker)(nil),                           // 75: sandwich.Sticker
+	(*VoiceState)(nil),                        // 76: sandwich.VoiceState
+	(*User)(nil),                              // 77: sandwich.User
+	(*emptypb.Empty)(nil),                     // 78: google.protobuf.Empty
 }
 var file_sandwich_proto_depIdxs = []int32{
-	0,  // 0: sandwich.FetchApplicationResponse.base_response:type_name -> sandwich.BaseResponse
-	37, // 1: sandwich.FetchApplicationResponse.applications:type_name -> sandwich.FetchApplicationResponse.ApplicationsEntry
-	38, // 2: sandwich.SandwichApplication.shards:type_name -> sandwich.SandwichApplication.ShardsEntry
-	0,  // 3: sandwich.WhereIsGuildResponse.base_response:type_name -> sandwich.BaseResponse
-	39, // 4: sandwich.WhereIsGuildResponse.locations:type_name -> sandwich.WhereIsGuildResponse.LocationsEntry
-	50, // 5: sandwich.WhereIsGuildLocation.guild_member:type_name -> sandwich.GuildMember
-	0,  // 6: sandwich.FetchGuildResponse.base_response:type_name -> sandwich.BaseResponse
-	40, // 7: sandwich.FetchGuildResponse.guilds:type_name -> sandwich.FetchGuildResponse.GuildsEntry
-	0,  // 8: sandwich.FetchGuildMemberResponse.base_response:type_name -> sandwich.BaseResponse
-	41, // 9: sandwich.FetchGuildMemberResponse.guild_members:type_name -> sandwich.FetchGuildMemberResponse.GuildMembersEntry
-	0,  // 10: sandwich.FetchGuildChannelResponse.base_response:type_name -> sandwich.BaseResponse
-	42, // 11: sandwich.FetchGuildChannelResponse.channels:type_name -> sandwich.FetchGuildChannelResponse.ChannelsEntry
-	0,  // 12: sandwich.FetchGuildRoleResponse.base_response:type_name -> sandwich.BaseResponse
-	43, // 13: sandwich.FetchGuildRoleResponse.roles:type_name -> sandwich.FetchGuildRoleResponse.RolesEntry
-	0,  // 14: sandwich.FetchGuildEmojiResponse.base_response:type_name -> sandwich.BaseResponse
-	44, // 15: sandwich.FetchGuildEmojiResponse.emojis:type_name -> sandwich.FetchGuildEmojiResponse.EmojisEntry
-	0,  // 16: sandwich.FetchGuildStickerResponse.base_response:type_name -> sandwich.BaseResponse
-	45, // 17: sandwich.FetchGuildStickerResponse.stickers:type_name -> sandwich.FetchGuildStickerResponse.StickersEntry
-	0,  // 18: sandwich.FetchGuildVoiceStateResponse.base_response:type_name -> sandwich.BaseResponse
-	46, // 19: sandwich.FetchGuildVoiceStateResponse.voice_states:type_name -> sandwich.FetchGuildVoiceStateResponse.VoiceStatesEntry
-	0,  // 20: sandwich.FetchUserResponse.base_response:type_name -> sandwich.BaseResponse
-	47, // 21: sandwich.FetchUserResponse.users:type_name -> sandwich.FetchUserResponse.UsersEntry
-	0,  // 22: sandwich.FetchUserMutualGuildsResponse.base_response:type_name -> sandwich.BaseResponse
-	48, // 23: sandwich.FetchUserMutualGuildsResponse.guilds:type_name -> sandwich.FetchUserMutualGuildsResponse.GuildsEntry
-	0,  // 24: sandwich.FetchGuildIDsResponse.base_response:type_name -> sandwich.BaseResponse
-	0,  // 25: sandwich.FetchVoiceStatesResponse.base_response:type_name -> sandwich.BaseResponse
-	49, // 26: sandwich.FetchVoiceStatesResponse.voice_states:type_name -> sandwich.FetchVoiceStatesResponse.VoiceStatesEntry
-	7,  // 27: sandwich.FetchApplicationResponse.ApplicationsEntry.value:type_name -> sandwich.SandwichApplication
-	8,  // 28: sandwich.SandwichApplication.ShardsEntry.value:type_name -> sandwich.Shard
-	14, // 29: sandwich.WhereIsGuildResponse.LocationsEntry.value:type_name -> sandwich.WhereIsGuildLocation
-	51, // 30: sandwich.FetchGuildResponse.GuildsEntry.value:type_name -> sandwich.Guild
-	50, // 31: sandwich.FetchGuildMemberResponse.GuildMembersEntry.value:type_name -> sandwich.GuildMember
-	52, // 32: sandwich.FetchGuildChannelResponse.ChannelsEntry.value:type_name -> sandwich.Channel
-	53, // 33: sandwich.FetchGuildRoleResponse.RolesEntry.value:type_name -> sandwich.Role
-	54, // 34: sandwich.FetchGuildEmojiResponse.EmojisEntry.value:type_name -> sandwich.Emoji
-	55, // 35: sandwich.FetchGuildStickerResponse.StickersEntry.value:type_name -> sandwich.Sticker
-	56, // 36: sandwich.FetchGuildVoiceStateResponse.VoiceStatesEntry.value:type_name -> sandwich.VoiceState
-	57, // 37: sandwich.FetchUserResponse.UsersEntry.value:type_name -> sandwich.User
-	51, // 38: sandwich.FetchUserMutualGuildsResponse.GuildsEntry.value:type_name -> sandwich.Guild
-	56, // 39: sandwich.FetchVoiceStatesResponse.VoiceStatesEntry.value:type_name -> sandwich.VoiceState
-	1,  // 40: sandwich.Sandwich.Listen:input_type -> sandwich.ListenRequest
-	11, // 41: sandwich.Sandwich.RelayMessage:input_type -> sandwich.RelayMessageRequest
-	58, // 42: sandwich.Sandwich.ReloadConfiguration:input_type -> google.protobuf.Empty
-	3,  // 43: sandwich.Sandwich.FetchApplication:input_type -> sandwich.ApplicationIdentifier
-	4,  // 44: sandwich.Sandwich.StartApplication:input_type -> sandwich.ApplicationIdentifierWithBlocking
-	4,  // 45: sandwich.Sandwich.StopApplication:input_type -> sandwich.ApplicationIdentifierWithBlocking
-	6,  // 46: sandwich.Sandwich.CreateApplication:input_type -> sandwich.CreateApplicationRequest
-	3,  // 47: sandwich.Sandwich.DeleteApplication:input_type -> sandwich.ApplicationIdentifier
-	9,  // 48: sandwich.Sandwich.RequestGuildChunk:input_type -> sandwich.RequestGuildChunkRequest
-	10, // 49: sandwich.Sandwich.SendWebsocketMessage:input_type -> sandwich.SendWebsocketMessageRequest
-	12, // 50: sandwich.Sandwich.WhereIsGuild:input_type -> sandwich.WhereIsGuildRequest
-	33, // 51: sandwich.Sandwich.FetchAllGuildIDs:input_type -> sandwich.FetchGuildIDsRequest
-	15, // 52: sandwich.Sandwich.FetchGuild:input_type -> sandwich.FetchGuildRequest
-	17, // 53: sandwich.Sandwich.FetchGuildMember:input_type -> sandwich.FetchGuildMemberRequest
-	19, // 54: sandwich.Sandwich.FetchGuildChannel:input_type -> sandwich.FetchGuildChannelRequest
-	21, // 55: sandwich.Sandwich.FetchGuildRole:input_type -> sandwich.FetchGuildRoleRequest
-	23, // 56: sandwich.Sandwich.FetchGuildEmoji:input_type -> sandwich.FetchGuildEmojiRequest
-	25, // 57: sandwich.Sandwich.FetchGuildSticker:input_type -> sandwich.FetchGuildStickerRequest
-	27, // 58: sandwich.Sandwich.FetchGuildVoiceState:input_type -> sandwich.FetchGuildVoiceStateRequest
-	29, // 59: sandwich.Sandwich.FetchUser:input_type -> sandwich.FetchUserRequest
-	31, // 60: sandwich.Sandwich.FetchUserMutualGuilds:input_type -> sandwich.FetchUserMutualGuildsRequest
-	35, // 61: sandwich.Sandwich.FetchVoiceStates:input_type -> sandwich.FetchVoiceStatesRequest
-	2,  // 62: sandwich.Sandwich.Listen:output_type -> sandwich.ListenResponse
-	0,  // 63: sandwich.Sandwich.RelayMessage:output_type -> sandwich.BaseResponse
-	0,  // 64: sandwich.Sandwich.ReloadConfiguration:output_type -> sandwich.BaseResponse
-	5,  // 65: sandwich.Sandwich.FetchApplication:output_type -> sandwich.FetchApplicationResponse
-	0,  // 66: sandwich.Sandwich.StartApplication:output_type -> sandwich.BaseResponse
-	0,  // 67: sandwich.Sandwich.StopApplication:output_type -> sandwich.BaseResponse
-	7,  // 68: sandwich.Sandwich.CreateApplication:output_type -> sandwich.SandwichApplication
-	0,  // 69: sandwich.Sandwich.DeleteApplication:output_type -> sandwich.BaseResponse
-	0,  // 70: sandwich.Sandwich.RequestGuildChunk:output_type -> sandwich.BaseResponse
-	0,  // 71: sandwich.Sandwich.SendWebsocketMessage:output_type -> sandwich.BaseResponse
-	13, // 72: sandwich.Sandwich.WhereIsGuild:output_type -> sandwich.WhereIsGuildResponse
-	34, // 73: sandwich.Sandwich.FetchAllGuildIDs:output_type -> sandwich.FetchGuildIDsResponse
-	16, // 74: sandwich.Sandwich.FetchGuild:output_type -> sandwich.FetchGuildResponse
-	18, // 75: sandwich.Sandwich.FetchGuildMember:output_type -> sandwich.FetchGuildMemberResponse
-	20, // 76: sandwich.Sandwich.FetchGuildChannel:output_type -> sandwich.FetchGuildChannelResponse
-	22, // 77: sandwich.Sandwich.FetchGuildRole:output_type -> sandwich.FetchGuildRoleResponse
-	24, // 78: sandwich.Sandwich.FetchGuildEmoji:output_type -> sandwich.FetchGuildEmojiResponse
-	26, // 79: sandwich.Sandwich.FetchGuildSticker:output_type -> sandwich.FetchGuildStickerResponse
-	28, // 80: sandwich.Sandwich.FetchGuildVoiceState:output_type -> sandwich.FetchGuildVoiceStateResponse
-	30, // 81: sandwich.Sandwich.FetchUser:output_type -> sandwich.FetchUserResponse
-	32, // 82: sandwich.Sandwich.FetchUserMutualGuilds:output_type -> sandwich.FetchUserMutualGuildsResponse
-	36, // 83: sandwich.Sandwich.FetchVoiceStates:output_type -> sandwich.FetchVoiceStatesResponse
-	62, // [62:84] is the sub-list for method output_type
-	40, // [40:62] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	0,  // 0: sandwich.ReconcileConfigurationResponse.base_response:type_name -> sandwich.BaseResponse
+	5,  // 1: sandwich.ReconcileConfigurationResponse.changes:type_name -> sandwich.ConfigurationChange
+	0,  // 2: sandwich.ValidateConfigurationResponse.base_response:type_name -> sandwich.BaseResponse
+	8,  // 3: sandwich.ValidateConfigurationResponse.errors:type_name -> sandwich.ConfigurationError
+	0,  // 4: sandwich.ListConfigVersionsResponse.base_response:type_name -> sandwich.BaseResponse
+	10, // 5: sandwich.ListConfigVersionsResponse.versions:type_name -> sandwich.ConfigVersion
+	0,  // 6: sandwich.ReplayDeadLettersResponse.base_response:type_name -> sandwich.BaseResponse
+	0,  // 7: sandwich.FetchApplicationResponse.base_response:type_name -> sandwich.BaseResponse
+	53, // 8: sandwich.FetchApplicationResponse.applications:type_name -> sandwich.FetchApplicationResponse.ApplicationsEntry
+	54, // 9: sandwich.SandwichApplication.shards:type_name -> sandwich.SandwichApplication.ShardsEntry
+	55, // 10: sandwich.DiscordRESTRequest.headers:type_name -> sandwich.DiscordRESTRequest.HeadersEntry
+	0,  // 11: sandwich.DiscordRESTResponse.base_response:type_name -> sandwich.BaseResponse
+	56, // 12: sandwich.DiscordRESTResponse.headers:type_name -> sandwich.DiscordRESTResponse.HeadersEntry
+	0,  // 13: sandwich.WhereIsGuildResponse.base_response:type_name -> sandwich.BaseResponse
+	57, // 14: sandwich.WhereIsGuildResponse.locations:type_name -> sandwich.WhereIsGuildResponse.LocationsEntry
+	70, // 15: sandwich.WhereIsGuildLocation.guild_member:type_name -> sandwich.GuildMember
+	0,  // 16: sandwich.FetchGuildResponse.base_response:type_name -> sandwich.BaseResponse
+	58, // 17: sandwich.FetchGuildResponse.guilds:type_name -> sandwich.FetchGuildResponse.GuildsEntry
+	0,  // 18: sandwich.FetchGuildMemberResponse.base_response:type_name -> sandwich.BaseResponse
+	59, // 19: sandwich.FetchGuildMemberResponse.guild_members:type_name -> sandwich.FetchGuildMemberResponse.GuildMembersEntry
+	0,  // 20: sandwich.FetchGuildChannelResponse.base_response:type_name -> sandwich.BaseResponse
+	60, // 21: sandwich.FetchGuildChannelResponse.channels:type_name -> sandwich.FetchGuildChannelResponse.ChannelsEntry
+	0,  // 22: sandwich.FetchGuildRoleResponse.base_response:type_name -> sandwich.BaseResponse
+	61, // 23: sandwich.FetchGuildRoleResponse.roles:type_name -> sandwich.FetchGuildRoleResponse.RolesEntry
+	0,  // 24: sandwich.FetchGuildEmojiResponse.base_response:type_name -> sandwich.BaseResponse
+	62, // 25: sandwich.FetchGuildEmojiResponse.emojis:type_name -> sandwich.FetchGuildEmojiResponse.EmojisEntry
+	0,  // 26: sandwich.FetchGuildStickerResponse.base_response:type_name -> sandwich.BaseResponse
+	63, // 27: sandwich.FetchGuildStickerResponse.stickers:type_name -> sandwich.FetchGuildStickerResponse.StickersEntry
+	0,  // 28: sandwich.FetchGuildVoiceStateResponse.base_response:type_name -> sandwich.BaseResponse
+	64, // 29: sandwich.FetchGuildVoiceStateResponse.voice_states:type_name -> sandwich.FetchGuildVoiceStateResponse.VoiceStatesEntry
+	0,  // 30: sandwich.FetchUserResponse.base_response:type_name -> sandwich.BaseResponse
+	65, // 31: sandwich.FetchUserResponse.users:type_name -> sandwich.FetchUserResponse.UsersEntry
+	0,  // 32: sandwich.FetchUserMutualGuildsResponse.base_response:type_name -> sandwich.BaseResponse
+	66, // 33: sandwich.FetchUserMutualGuildsResponse.guilds:type_name -> sandwich.FetchUserMutualGuildsResponse.GuildsEntry
+	0,  // 34: sandwich.FetchGuildIDsResponse.base_response:type_name -> sandwich.BaseResponse
+	0,  // 35: sandwich.FetchVoiceStatesResponse.base_response:type_name -> sandwich.BaseResponse
+	67, // 36: sandwich.FetchVoiceStatesResponse.voice_states:type_name -> sandwich.FetchVoiceStatesResponse.VoiceStatesEntry
+	68, // 37: sandwich.ProducedPayload.extra:type_name -> sandwich.ProducedPayload.ExtraEntry
+	52, // 38: sandwich.ProducedPayload.metadata:type_name -> sandwich.ProducedMetadata
+	69, // 39: sandwich.ProducedPayload.trace:type_name -> sandwich.ProducedPayload.TraceEntry
+	19, // 40: sandwich.FetchApplicationResponse.ApplicationsEntry.value:type_name -> sandwich.SandwichApplication
+	20, // 41: sandwich.SandwichApplication.ShardsEntry.value:type_name -> sandwich.Shard
+	28, // 42: sandwich.WhereIsGuildResponse.LocationsEntry.value:type_name -> sandwich.WhereIsGuildLocation
+	71, // 43: sandwich.FetchGuildResponse.GuildsEntry.value:type_name -> sandwich.Guild
+	70, // 44: sandwich.FetchGuildMemberResponse.GuildMembersEntry.value:type_name -> sandwich.GuildMember
+	72, // 45: sandwich.FetchGuildChannelResponse.ChannelsEntry.value:type_name -> sandwich.Channel
+	73, // 46: sandwich.FetchGuildRoleResponse.RolesEntry.value:type_name -> sandwich.Role
+	74, // 47: sandwich.FetchGuildEmojiResponse.EmojisEntry.value:type_name -> sandwich.Emoji
+	75, // 48: sandwich.FetchGuildStickerResponse.StickersEntry.value:type_name -> sandwich.Sticker
+	76, // 49: sandwich.FetchGuildVoiceStateResponse.VoiceStatesEntry.value:type_name -> sandwich.VoiceState
+	77, // 50: sandwich.FetchUserResponse.UsersEntry.value:type_name -> sandwich.User
+	71, // 51: sandwich.FetchUserMutualGuildsResponse.GuildsEntry.value:type_name -> sandwich.Guild
+	76, // 52: sandwich.FetchVoiceStatesResponse.VoiceStatesEntry.value:type_name -> sandwich.VoiceState
+	1,  // 53: sandwich.Sandwich.Listen:input_type -> sandwich.ListenRequest
+	25, // 54: sandwich.Sandwich.RelayMessage:input_type -> sandwich.RelayMessageRequest
+	78, // 55: sandwich.Sandwich.ReloadConfiguration:input_type -> google.protobuf.Empty
+	3,  // 56: sandwich.Sandwich.ReconcileConfiguration:input_type -> sandwich.ReconcileConfigurationRequest
+	6,  // 57: sandwich.Sandwich.ValidateConfiguration:input_type -> sandwich.ValidateConfigurationRequest
+	78, // 58: sandwich.Sandwich.ListConfigVersions:input_type -> google.protobuf.Empty
+	11, // 59: sandwich.Sandwich.RollbackConfig:input_type -> sandwich.RollbackConfigRequest
+	14, // 60: sandwich.Sandwich.FetchApplication:input_type -> sandwich.FetchApplicationRequest
+	13, // 61: sandwich.Sandwich.StartApplication:input_type -> sandwich.ApplicationIdentifierWithBlocking
+	13, // 62: sandwich.Sandwich.StopApplication:input_type -> sandwich.ApplicationIdentifierWithBlocking
+	18, // 63: sandwich.Sandwich.CreateApplication:input_type -> sandwich.CreateApplicationRequest
+	12, // 64: sandwich.Sandwich.DeleteApplication:input_type -> sandwich.ApplicationIdentifier
+	15, // 65: sandwich.Sandwich.RotateApplicationToken:input_type -> sandwich.RotateApplicationTokenRequest
+	12, // 66: sandwich.Sandwich.ReplayDeadLetters:input_type -> sandwich.ApplicationIdentifier
+	21, // 67: sandwich.Sandwich.RequestGuildChunk:input_type -> sandwich.RequestGuildChunkRequest
+	22, // 68: sandwich.Sandwich.SendWebsocketMessage:input_type -> sandwich.SendWebsocketMessageRequest
+	23, // 69: sandwich.Sandwich.DiscordREST:input_type -> sandwich.DiscordRESTRequest
+	26, // 70: sandwich.Sandwich.WhereIsGuild:input_type -> sandwich.WhereIsGuildRequest
+	47, // 71: sandwich.Sandwich.FetchAllGuildIDs:input_type -> sandwich.FetchGuildIDsRequest
+	29, // 72: sandwich.Sandwich.FetchGuild:input_type -> sandwich.FetchGuildRequest
+	31, // 73: sandwich.Sandwich.FetchGuildMember:input_type -> sandwich.FetchGuildMemberRequest
+	33, // 74: sandwich.Sandwich.FetchGuildChannel:input_type -> sandwich.FetchGuildChannelRequest
+	35, // 75: sandwich.Sandwich.FetchGuildRole:input_type -> sandwich.FetchGuildRoleRequest
+	37, // 76: sandwich.Sandwich.FetchGuildEmoji:input_type -> sandwich.FetchGuildEmojiRequest
+	39, // 77: sandwich.Sandwich.FetchGuildSticker:input_type -> sandwich.FetchGuildStickerRequest
+	41, // 78: sandwich.Sandwich.FetchGuildVoiceState:input_type -> sandwich.FetchGuildVoiceStateRequest
+	43, // 79: sandwich.Sandwich.FetchUser:input_type -> sandwich.FetchUserRequest
+	45, // 80: sandwich.Sandwich.FetchUserMutualGuilds:input_type -> sandwich.FetchUserMutualGuildsRequest
+	49, // 81: sandwich.Sandwich.FetchVoiceStates:input_type -> sandwich.FetchVoiceStatesRequest
+	2,  // 82: sandwich.Sandwich.Listen:output_type -> sandwich.ListenResponse
+	0,  // 83: sandwich.Sandwich.RelayMessage:output_type -> sandwich.BaseResponse
+	0,  // 84: sandwich.Sandwich.ReloadConfiguration:output_type -> sandwich.BaseResponse
+	4,  // 85: sandwich.Sandwich.ReconcileConfiguration:output_type -> sandwich.ReconcileConfigurationResponse
+	7,  // 86: sandwich.Sandwich.ValidateConfiguration:output_type -> sandwich.ValidateConfigurationResponse
+	9,  // 87: sandwich.Sandwich.ListConfigVersions:output_type -> sandwich.ListConfigVersionsResponse
+	4,  // 88: sandwich.Sandwich.RollbackConfig:output_type -> sandwich.ReconcileConfigurationResponse
+	17, // 89: sandwich.Sandwich.FetchApplication:output_type -> sandwich.FetchApplicationResponse
+	0,  // 90: sandwich.Sandwich.StartApplication:output_type -> sandwich.BaseResponse
+	0,  // 91: sandwich.Sandwich.StopApplication:output_type -> sandwich.BaseResponse
+	19, // 92: sandwich.Sandwich.CreateApplication:output_type -> sandwich.SandwichApplication
+	0,  // 93: sandwich.Sandwich.DeleteApplication:output_type -> sandwich.BaseResponse
+	0,  // 94: sandwich.Sandwich.RotateApplicationToken:output_type -> sandwich.BaseResponse
+	16, // 95: sandwich.Sandwich.ReplayDeadLetters:output_type -> sandwich.ReplayDeadLettersResponse
+	0,  // 96: sandwich.Sandwich.RequestGuildChunk:output_type -> sandwich.BaseResponse
+	0,  // 97: sandwich.Sandwich.SendWebsocketMessage:output_type -> sandwich.BaseResponse
+	24, // 98: sandwich.Sandwich.DiscordREST:output_type -> sandwich.DiscordRESTResponse
+	27, // 99: sandwich.Sandwich.WhereIsGuild:output_type -> sandwich.WhereIsGuildResponse
+	48, // 100: sandwich.Sandwich.FetchAllGuildIDs:output_type -> sandwich.FetchGuildIDsResponse
+	30, // 101: sandwich.Sandwich.FetchGuild:output_type -> sandwich.FetchGuildResponse
+	32, // 102: sandwich.Sandwich.FetchGuildMember:output_type -> sandwich.FetchGuildMemberResponse
+	34, // 103: sandwich.Sandwich.FetchGuildChannel:output_type -> sandwich.FetchGuildChannelResponse
+	36, // 104: sandwich.Sandwich.FetchGuildRole:output_type -> sandwich.FetchGuildRoleResponse
+	38, // 105: sandwich.Sandwich.FetchGuildEmoji:output_type -> sandwich.FetchGuildEmojiResponse
+	40, // 106: sandwich.Sandwich.FetchGuildSticker:output_type -> sandwich.FetchGuildStickerResponse
+	42, // 107: sandwich.Sandwich.FetchGuildVoiceState:output_type -> sandwich.FetchGuildVoiceStateResponse
+	44, // 108: sandwich.Sandwich.FetchUser:output_type -> sandwich.FetchUserResponse
+	46, // 109: sandwich.Sandwich.FetchUserMutualGuilds:output_type -> sandwich.FetchUserMutualGuildsResponse
+	50, // 110: sandwich.Sandwich.FetchVoiceStates:output_type -> sandwich.FetchVoiceStatesResponse
+	82, // [82:111] is the sub-list for method output_type
+	53, // [53:82] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_sandwich_proto_init() }
@@ -3018,7 +4303,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplicationIdentifier); i {
+			switch v := v.(*ReconcileConfigurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3030,7 +4315,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplicationIdentifierWithBlocking); i {
+			switch v := v.(*ReconcileConfigurationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3042,7 +4327,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchApplicationResponse); i {
+			switch v := v.(*ConfigurationChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3054,7 +4339,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateApplicationRequest); i {
+			switch v := v.(*ValidateConfigurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3066,7 +4351,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SandwichApplication); i {
+			switch v := v.(*ValidateConfigurationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3078,7 +4363,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Shard); i {
+			switch v := v.(*ConfigurationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3090,7 +4375,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestGuildChunkRequest); i {
+			switch v := v.(*ListConfigVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3102,7 +4387,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendWebsocketMessageRequest); i {
+			switch v := v.(*ConfigVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3114,7 +4399,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelayMessageRequest); i {
+			switch v := v.(*RollbackConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3126,7 +4411,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhereIsGuildRequest); i {
+			switch v := v.(*ApplicationIdentifier); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3138,7 +4423,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhereIsGuildResponse); i {
+			switch v := v.(*ApplicationIdentifierWithBlocking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3150,7 +4435,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhereIsGuildLocation); i {
+			switch v := v.(*FetchApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3162,7 +4447,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildRequest); i {
+			switch v := v.(*RotateApplicationTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3174,7 +4459,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildResponse); i {
+			switch v := v.(*ReplayDeadLettersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3186,7 +4471,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildMemberRequest); i {
+			switch v := v.(*FetchApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3198,7 +4483,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildMemberResponse); i {
+			switch v := v.(*CreateApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3210,7 +4495,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildChannelRequest); i {
+			switch v := v.(*SandwichApplication); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3222,7 +4507,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildChannelResponse); i {
+			switch v := v.(*Shard); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3234,7 +4519,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildRoleRequest); i {
+			switch v := v.(*RequestGuildChunkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3246,7 +4531,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildRoleResponse); i {
+			switch v := v.(*SendWebsocketMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3258,7 +4543,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildEmojiRequest); i {
+			switch v := v.(*DiscordRESTRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3270,7 +4555,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildEmojiResponse); i {
+			switch v := v.(*DiscordRESTResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3282,7 +4567,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildStickerRequest); i {
+			switch v := v.(*RelayMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3294,7 +4579,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildStickerResponse); i {
+			switch v := v.(*WhereIsGuildRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3306,7 +4591,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildVoiceStateRequest); i {
+			switch v := v.(*WhereIsGuildResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3318,7 +4603,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildVoiceStateResponse); i {
+			switch v := v.(*WhereIsGuildLocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3330,7 +4615,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchUserRequest); i {
+			switch v := v.(*FetchGuildRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3342,7 +4627,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchUserResponse); i {
+			switch v := v.(*FetchGuildResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3354,7 +4639,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchUserMutualGuildsRequest); i {
+			switch v := v.(*FetchGuildMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3366,7 +4651,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchUserMutualGuildsResponse); i {
+			switch v := v.(*FetchGuildMemberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3378,7 +4663,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildIDsRequest); i {
+			switch v := v.(*FetchGuildChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3390,7 +4675,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchGuildIDsResponse); i {
+			switch v := v.(*FetchGuildChannelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3402,7 +4687,7 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchVoiceStatesRequest); i {
+			switch v := v.(*FetchGuildRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3414,6 +4699,174 @@ func file_sandwich_proto_init() {
 			}
 		}
 		file_sandwich_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchGuildRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchGuildEmojiRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchGuildEmojiResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchGuildStickerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchGuildStickerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchGuildVoiceStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchGuildVoiceStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchUserMutualGuildsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchUserMutualGuildsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchGuildIDsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchGuildIDsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchVoiceStatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FetchVoiceStatesResponse); i {
 			case 0:
 				return &v.state
@@ -3425,6 +4878,30 @@ func file_sandwich_proto_init() {
 				return nil
 			}
 		}
+		file_sandwich_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProducedPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sandwich_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProducedMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3432,7 +4909,7 @@ func file_sandwich_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sandwich_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   50,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,28 +20,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Sandwich_Listen_FullMethodName                = "/sandwich.Sandwich/Listen"
-	Sandwich_RelayMessage_FullMethodName          = "/sandwich.Sandwich/RelayMessage"
-	Sandwich_ReloadConfiguration_FullMethodName   = "/sandwich.Sandwich/ReloadConfiguration"
-	Sandwich_FetchApplication_FullMethodName      = "/sandwich.Sandwich/FetchApplication"
-	Sandwich_StartApplication_FullMethodName      = "/sandwich.Sandwich/StartApplication"
-	Sandwich_StopApplication_FullMethodName       = "/sandwich.Sandwich/StopApplication"
-	Sandwich_CreateApplication_FullMethodName     = "/sandwich.Sandwich/CreateApplication"
-	Sandwich_DeleteApplication_FullMethodName     = "/sandwich.Sandwich/DeleteApplication"
-	Sandwich_RequestGuildChunk_FullMethodName     = "/sandwich.Sandwich/RequestGuildChunk"
-	Sandwich_SendWebsocketMessage_FullMethodName  = "/sandwich.Sandwich/SendWebsocketMessage"
-	Sandwich_WhereIsGuild_FullMethodName          = "/sandwich.Sandwich/WhereIsGuild"
-	Sandwich_FetchAllGuildIDs_FullMethodName      = "/sandwich.Sandwich/FetchAllGuildIDs"
-	Sandwich_FetchGuild_FullMethodName            = "/sandwich.Sandwich/FetchGuild"
-	Sandwich_FetchGuildMember_FullMethodName      = "/sandwich.Sandwich/FetchGuildMember"
-	Sandwich_FetchGuildChannel_FullMethodName     = "/sandwich.Sandwich/FetchGuildChannel"
-	Sandwich_FetchGuildRole_FullMethodName        = "/sandwich.Sandwich/FetchGuildRole"
-	Sandwich_FetchGuildEmoji_FullMethodName       = "/sandwich.Sandwich/FetchGuildEmoji"
-	Sandwich_FetchGuildSticker_FullMethodName     = "/sandwich.Sandwich/FetchGuildSticker"
-	Sandwich_FetchGuildVoiceState_FullMethodName  = "/sandwich.Sandwich/FetchGuildVoiceState"
-	Sandwich_FetchUser_FullMethodName             = "/sandwich.Sandwich/FetchUser"
-	Sandwich_FetchUserMutualGuilds_FullMethodName = "/sandwich.Sandwich/FetchUserMutualGuilds"
-	Sandwich_FetchVoiceStates_FullMethodName      = "/sandwich.Sandwich/FetchVoiceStates"
+	Sandwich_Listen_FullMethodName                 = "/sandwich.Sandwich/Listen"
+	Sandwich_RelayMessage_FullMethodName           = "/sandwich.Sandwich/RelayMessage"
+	Sandwich_ReloadConfiguration_FullMethodName    = "/sandwich.Sandwich/ReloadConfiguration"
+	Sandwich_ReconcileConfiguration_FullMethodName = "/sandwich.Sandwich/ReconcileConfiguration"
+	Sandwich_ValidateConfiguration_FullMethodName  = "/sandwich.Sandwich/ValidateConfiguration"
+	Sandwich_ListConfigVersions_FullMethodName     = "/sandwich.Sandwich/ListConfigVersions"
+	Sandwich_RollbackConfig_FullMethodName         = "/sandwich.Sandwich/RollbackConfig"
+	Sandwich_FetchApplication_FullMethodName       = "/sandwich.Sandwich/FetchApplication"
+	Sandwich_StartApplication_FullMethodName       = "/sandwich.Sandwich/StartApplication"
+	Sandwich_StopApplication_FullMethodName        = "/sandwich.Sandwich/StopApplication"
+	Sandwich_CreateApplication_FullMethodName      = "/sandwich.Sandwich/CreateApplication"
+	Sandwich_DeleteApplication_FullMethodName      = "/sandwich.Sandwich/DeleteApplication"
+	Sandwich_RotateApplicationToken_FullMethodName = "/sandwich.Sandwich/RotateApplicationToken"
+	Sandwich_ReplayDeadLetters_FullMethodName      = "/sandwich.Sandwich/ReplayDeadLetters"
+	Sandwich_RequestGuildChunk_FullMethodName      = "/sandwich.Sandwich/RequestGuildChunk"
+	Sandwich_SendWebsocketMessage_FullMethodName   = "/sandwich.Sandwich/SendWebsocketMessage"
+	Sandwich_DiscordREST_FullMethodName            = "/sandwich.Sandwich/DiscordREST"
+	Sandwich_WhereIsGuild_FullMethodName           = "/sandwich.Sandwich/WhereIsGuild"
+	Sandwich_FetchAllGuildIDs_FullMethodName       = "/sandwich.Sandwich/FetchAllGuildIDs"
+	Sandwich_FetchGuild_FullMethodName             = "/sandwich.Sandwich/FetchGuild"
+	Sandwich_FetchGuildMember_FullMethodName       = "/sandwich.Sandwich/FetchGuildMember"
+	Sandwich_FetchGuildChannel_FullMethodName      = "/sandwich.Sandwich/FetchGuildChannel"
+	Sandwich_FetchGuildRole_FullMethodName         = "/sandwich.Sandwich/FetchGuildRole"
+	Sandwich_FetchGuildEmoji_FullMethodName        = "/sandwich.Sandwich/FetchGuildEmoji"
+	Sandwich_FetchGuildSticker_FullMethodName      = "/sandwich.Sandwich/FetchGuildSticker"
+	Sandwich_FetchGuildVoiceState_FullMethodName   = "/sandwich.Sandwich/FetchGuildVoiceState"
+	Sandwich_FetchUser_FullMethodName              = "/sandwich.Sandwich/FetchUser"
+	Sandwich_FetchUserMutualGuilds_FullMethodName  = "/sandwich.Sandwich/FetchUserMutualGuilds"
+	Sandwich_FetchVoiceStates_FullMethodName       = "/sandwich.Sandwich/FetchVoiceStates"
 )
 
 // SandwichClient is the client API for Sandwich service.
@@ -52,10 +59,21 @@ type SandwichClient interface {
 	Listen(ctx context.Context, in *ListenRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListenResponse], error)
 	// RelayMessage creates a new event and sends it immediately back to Applications.
 	RelayMessage(ctx context.Context, in *RelayMessageRequest, opts ...grpc.CallOption) (*BaseResponse, error)
-	// ReloadConfiguration reloads the configuration.
+	// ReloadConfiguration reloads the configuration and reconciles running applications with it.
 	ReloadConfiguration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BaseResponse, error)
+	// ReconcileConfiguration reloads the configuration and reconciles running applications with it,
+	// reporting what changed. With dry_run set, the changes are reported without being applied.
+	ReconcileConfiguration(ctx context.Context, in *ReconcileConfigurationRequest, opts ...grpc.CallOption) (*ReconcileConfigurationResponse, error)
+	// ValidateConfiguration validates a configuration without applying it.
+	// If no configuration is passed, the configuration from the config provider is validated.
+	ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error)
+	// ListConfigVersions returns the saved versions of the configuration, oldest first.
+	ListConfigVersions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListConfigVersionsResponse, error)
+	// RollbackConfig restores a saved version of the configuration and reconciles running applications with it.
+	RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*ReconcileConfigurationResponse, error)
 	// FetchApplication returns the Application Configuration.
-	FetchApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*FetchApplicationResponse, error)
+	// Bot tokens are redacted unless include_bot_token is set.
+	FetchApplication(ctx context.Context, in *FetchApplicationRequest, opts ...grpc.CallOption) (*FetchApplicationResponse, error)
 	// StartApplication starts a Application.
 	StartApplication(ctx context.Context, in *ApplicationIdentifierWithBlocking, opts ...grpc.CallOption) (*BaseResponse, error)
 	// StopApplication stops a Application.
@@ -64,10 +82,19 @@ type SandwichClient interface {
 	CreateApplication(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*SandwichApplication, error)
 	// DeleteApplication deletes a Application.
 	DeleteApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*BaseResponse, error)
+	// RotateApplicationToken replaces the bot token of an Application without stopping it.
+	// The token must belong to the same bot. Shards re-identify max_concurrency shards at a time.
+	RotateApplicationToken(ctx context.Context, in *RotateApplicationTokenRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// ReplayDeadLetters publishes the events that the producers of an Application failed to publish again.
+	// Events that fail again are kept in the dead letter file.
+	ReplayDeadLetters(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error)
 	// RequestGuildChunk requests a guild chunk.
 	RequestGuildChunk(ctx context.Context, in *RequestGuildChunkRequest, opts ...grpc.CallOption) (*BaseResponse, error)
 	// SendWebsocketMessage sends a websocket message to discord from a specific shard.
 	SendWebsocketMessage(ctx context.Context, in *SendWebsocketMessageRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// DiscordREST sends a request to the Discord REST API using the bot token of an Application.
+	// Requests wait for the rate limits of their route, which are shared by every caller.
+	DiscordREST(ctx context.Context, in *DiscordRESTRequest, opts ...grpc.CallOption) (*DiscordRESTResponse, error)
 	// WhereIsGuild returns a list of WhereIsGuildLocations based on guildId.
 	WhereIsGuild(ctx context.Context, in *WhereIsGuildRequest, opts ...grpc.CallOption) (*WhereIsGuildResponse, error)
 	FetchAllGuildIDs(ctx context.Context, in *FetchGuildIDsRequest, opts ...grpc.CallOption) (*FetchGuildIDsResponse, error)
@@ -130,7 +157,47 @@ func (c *sandwichClient) ReloadConfiguration(ctx context.Context, in *emptypb.Em
 	return out, nil
 }
 
-func (c *sandwichClient) FetchApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*FetchApplicationResponse, error) {
+func (c *sandwichClient) ReconcileConfiguration(ctx context.Context, in *ReconcileConfigurationRequest, opts ...grpc.CallOption) (*ReconcileConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileConfigurationResponse)
+	err := c.cc.Invoke(ctx, Sandwich_ReconcileConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandwichClient) ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateConfigurationResponse)
+	err := c.cc.Invoke(ctx, Sandwich_ValidateConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandwichClient) ListConfigVersions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListConfigVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConfigVersionsResponse)
+	err := c.cc.Invoke(ctx, Sandwich_ListConfigVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandwichClient) RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*ReconcileConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileConfigurationResponse)
+	err := c.cc.Invoke(ctx, Sandwich_RollbackConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandwichClient) FetchApplication(ctx context.Context, in *FetchApplicationRequest, opts ...grpc.CallOption) (*FetchApplicationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FetchApplicationResponse)
 	err := c.cc.Invoke(ctx, Sandwich_FetchApplication_FullMethodName, in, out, cOpts...)
@@ -180,6 +247,26 @@ func (c *sandwichClient) DeleteApplication(ctx context.Context, in *ApplicationI
 	return out, nil
 }
 
+func (c *sandwichClient) RotateApplicationToken(ctx context.Context, in *RotateApplicationTokenRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, Sandwich_RotateApplicationToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandwichClient) ReplayDeadLetters(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDeadLettersResponse)
+	err := c.cc.Invoke(ctx, Sandwich_ReplayDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sandwichClient) RequestGuildChunk(ctx context.Context, in *RequestGuildChunkRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BaseResponse)
@@ -200,6 +287,16 @@ func (c *sandwichClient) SendWebsocketMessage(ctx context.Context, in *SendWebso
 	return out, nil
 }
 
+func (c *sandwichClient) DiscordREST(ctx context.Context, in *DiscordRESTRequest, opts ...grpc.CallOption) (*DiscordRESTResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscordRESTResponse)
+	err := c.cc.Invoke(ctx, Sandwich_DiscordREST_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sandwichClient) WhereIsGuild(ctx context.Context, in *WhereIsGuildRequest, opts ...grpc.CallOption) (*WhereIsGuildResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WhereIsGuildResponse)
@@ -328,10 +425,21 @@ type SandwichServer interface {
 	Listen(*ListenRequest, grpc.ServerStreamingServer[ListenResponse]) error
 	// RelayMessage creates a new event and sends it immediately back to Applications.
 	RelayMessage(context.Context, *RelayMessageRequest) (*BaseResponse, error)
-	// ReloadConfiguration reloads the configuration.
+	// ReloadConfiguration reloads the configuration and reconciles running applications with it.
 	ReloadConfiguration(context.Context, *emptypb.Empty) (*BaseResponse, error)
+	// ReconcileConfiguration reloads the configuration and reconciles running applications with it,
+	// reporting what changed. With dry_run set, the changes are reported without being applied.
+	ReconcileConfiguration(context.Context, *ReconcileConfigurationRequest) (*ReconcileConfigurationResponse, error)
+	// ValidateConfiguration validates a configuration without applying it.
+	// If no configuration is passed, the configuration from the config provider is validated.
+	ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error)
+	// ListConfigVersions returns the saved versions of the configuration, oldest first.
+	ListConfigVersions(context.Context, *emptypb.Empty) (*ListConfigVersionsResponse, error)
+	// RollbackConfig restores a saved version of the configuration and reconciles running applications with it.
+	RollbackConfig(context.Context, *RollbackConfigRequest) (*ReconcileConfigurationResponse, error)
 	// FetchApplication returns the Application Configuration.
-	FetchApplication(context.Context, *ApplicationIdentifier) (*FetchApplicationResponse, error)
+	// Bot tokens are redacted unless include_bot_token is set.
+	FetchApplication(context.Context, *FetchApplicationRequest) (*FetchApplicationResponse, error)
 	// StartApplication starts a Application.
 	StartApplication(context.Context, *ApplicationIdentifierWithBlocking) (*BaseResponse, error)
 	// StopApplication stops a Application.
@@ -340,10 +448,19 @@ type SandwichServer interface {
 	CreateApplication(context.Context, *CreateApplicationRequest) (*SandwichApplication, error)
 	// DeleteApplication deletes a Application.
 	DeleteApplication(context.Context, *ApplicationIdentifier) (*BaseResponse, error)
+	// RotateApplicationToken replaces the bot token of an Application without stopping it.
+	// The token must belong to the same bot. Shards re-identify max_concurrency shards at a time.
+	RotateApplicationToken(context.Context, *RotateApplicationTokenRequest) (*BaseResponse, error)
+	// ReplayDeadLetters publishes the events that the producers of an Application failed to publish again.
+	// Events that fail again are kept in the dead letter file.
+	ReplayDeadLetters(context.Context, *ApplicationIdentifier) (*ReplayDeadLettersResponse, error)
 	// RequestGuildChunk requests a guild chunk.
 	RequestGuildChunk(context.Context, *RequestGuildChunkRequest) (*BaseResponse, error)
 	// SendWebsocketMessage sends a websocket message to discord from a specific shard.
 	SendWebsocketMessage(context.Context, *SendWebsocketMessageRequest) (*BaseResponse, error)
+	// DiscordREST sends a request to the Discord REST API using the bot token of an Application.
+	// Requests wait for the rate limits of their route, which are shared by every caller.
+	DiscordREST(context.Context, *DiscordRESTRequest) (*DiscordRESTResponse, error)
 	// WhereIsGuild returns a list of WhereIsGuildLocations based on guildId.
 	WhereIsGuild(context.Context, *WhereIsGuildRequest) (*WhereIsGuildResponse, error)
 	FetchAllGuildIDs(context.Context, *FetchGuildIDsRequest) (*FetchGuildIDsResponse, error)
@@ -376,7 +493,19 @@ func (UnimplementedSandwichServer) RelayMessage(context.Context, *RelayMessageRe
 func (UnimplementedSandwichServer) ReloadConfiguration(context.Context, *emptypb.Empty) (*BaseResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReloadConfiguration not implemented")
 }
-func (UnimplementedSandwichServer) FetchApplication(context.Context, *ApplicationIdentifier) (*FetchApplicationResponse, error) {
+func (UnimplementedSandwichServer) ReconcileConfiguration(context.Context, *ReconcileConfigurationRequest) (*ReconcileConfigurationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReconcileConfiguration not implemented")
+}
+func (UnimplementedSandwichServer) ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateConfiguration not implemented")
+}
+func (UnimplementedSandwichServer) ListConfigVersions(context.Context, *emptypb.Empty) (*ListConfigVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListConfigVersions not implemented")
+}
+func (UnimplementedSandwichServer) RollbackConfig(context.Context, *RollbackConfigRequest) (*ReconcileConfigurationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackConfig not implemented")
+}
+func (UnimplementedSandwichServer) FetchApplication(context.Context, *FetchApplicationRequest) (*FetchApplicationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method FetchApplication not implemented")
 }
 func (UnimplementedSandwichServer) StartApplication(context.Context, *ApplicationIdentifierWithBlocking) (*BaseResponse, error) {
@@ -391,12 +520,21 @@ func (UnimplementedSandwichServer) CreateApplication(context.Context, *CreateApp
 func (UnimplementedSandwichServer) DeleteApplication(context.Context, *ApplicationIdentifier) (*BaseResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteApplication not implemented")
 }
+func (UnimplementedSandwichServer) RotateApplicationToken(context.Context, *RotateApplicationTokenRequest) (*BaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateApplicationToken not implemented")
+}
+func (UnimplementedSandwichServer) ReplayDeadLetters(context.Context, *ApplicationIdentifier) (*ReplayDeadLettersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
 func (UnimplementedSandwichServer) RequestGuildChunk(context.Context, *RequestGuildChunkRequest) (*BaseResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RequestGuildChunk not implemented")
 }
 func (UnimplementedSandwichServer) SendWebsocketMessage(context.Context, *SendWebsocketMessageRequest) (*BaseResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendWebsocketMessage not implemented")
 }
+func (UnimplementedSandwichServer) DiscordREST(context.Context, *DiscordRESTRequest) (*DiscordRESTResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscordREST not implemented")
+}
 func (UnimplementedSandwichServer) WhereIsGuild(context.Context, *WhereIsGuildRequest) (*WhereIsGuildResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method WhereIsGuild not implemented")
 }
@@ -501,8 +639,80 @@ func _Sandwich_ReloadConfiguration_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sandwich_ReconcileConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandwichServer).ReconcileConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sandwich_ReconcileConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandwichServer).ReconcileConfiguration(ctx, req.(*ReconcileConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sandwich_ValidateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandwichServer).ValidateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sandwich_ValidateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandwichServer).ValidateConfiguration(ctx, req.(*ValidateConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sandwich_ListConfigVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandwichServer).ListConfigVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sandwich_ListConfigVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandwichServer).ListConfigVersions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sandwich_RollbackConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandwichServer).RollbackConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sandwich_RollbackConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandwichServer).RollbackConfig(ctx, req.(*RollbackConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Sandwich_FetchApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationIdentifier)
+	in := new(FetchApplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -514,7 +724,7 @@ func _Sandwich_FetchApplication_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: Sandwich_FetchApplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SandwichServer).FetchApplication(ctx, req.(*ApplicationIdentifier))
+		return srv.(SandwichServer).FetchApplication(ctx, req.(*FetchApplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -591,6 +801,42 @@ func _Sandwich_DeleteApplication_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sandwich_RotateApplicationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApplicationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandwichServer).RotateApplicationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sandwich_RotateApplicationToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandwichServer).RotateApplicationToken(ctx, req.(*RotateApplicationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sandwich_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandwichServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sandwich_ReplayDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandwichServer).ReplayDeadLetters(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Sandwich_RequestGuildChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RequestGuildChunkRequest)
 	if err := dec(in); err != nil {
@@ -627,6 +873,24 @@ func _Sandwich_SendWebsocketMessage_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sandwich_DiscordREST_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscordRESTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandwichServer).DiscordREST(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sandwich_DiscordREST_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandwichServer).DiscordREST(ctx, req.(*DiscordRESTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Sandwich_WhereIsGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WhereIsGuildRequest)
 	if err := dec(in); err != nil {
@@ -858,6 +1122,22 @@ var Sandwich_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReloadConfiguration",
 			Handler:    _Sandwich_ReloadConfiguration_Handler,
 		},
+		{
+			MethodName: "ReconcileConfiguration",
+			Handler:    _Sandwich_ReconcileConfiguration_Handler,
+		},
+		{
+			MethodName: "ValidateConfiguration",
+			Handler:    _Sandwich_ValidateConfiguration_Handler,
+		},
+		{
+			MethodName: "ListConfigVersions",
+			Handler:    _Sandwich_ListConfigVersions_Handler,
+		},
+		{
+			MethodName: "RollbackConfig",
+			Handler:    _Sandwich_RollbackConfig_Handler,
+		},
 		{
 			MethodName: "FetchApplication",
 			Handler:    _Sandwich_FetchApplication_Handler,
@@ -878,6 +1158,14 @@ var Sandwich_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApplication",
 			Handler:    _Sandwich_DeleteApplication_Handler,
 		},
+		{
+			MethodName: "RotateApplicationToken",
+			Handler:    _Sandwich_RotateApplicationToken_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _Sandwich_ReplayDeadLetters_Handler,
+		},
 		{
 			MethodName: "RequestGuildChunk",
 			Handler:    _Sandwich_RequestGuildChunk_Handler,
@@ -886,6 +1174,10 @@ var Sandwich_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendWebsocketMessage",
 			Handler:    _Sandwich_SendWebsocketMessage_Handler,
 		},
+		{
+			MethodName: "DiscordREST",
+			Handler:    _Sandwich_DiscordREST_Handler,
+		},
 		{
 			MethodName: "WhereIsGuild",
 			Handler:    _Sandwich_WhereIsGuild_Handler,
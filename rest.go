@@ -0,0 +1,280 @@
+package sandwich
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/WelcomerTeam/Discord/discord"
+	"github.com/WelcomerTeam/Sandwich-Daemon/pkg/bucketstore"
+	"github.com/WelcomerTeam/Sandwich-Daemon/pkg/syncmap"
+)
+
+var (
+	// RESTGlobalLimit is the number of requests a bot token can make each second across all routes.
+	RESTGlobalLimit int32 = 50
+
+	// RESTMaxAttempts is how many times a request is sent before a rate limited response is returned to the caller.
+	RESTMaxAttempts = 3
+
+	// RESTMaxRetryAfter is the longest a request will wait on a rate limit before it is returned to the caller.
+	RESTMaxRetryAfter = time.Second * 30
+
+	// RESTMaxResponseSize is the largest response body that will be read.
+	RESTMaxResponseSize int64 = 16 << 20
+)
+
+// RESTRequest is a request to the Discord REST API.
+type RESTRequest struct {
+	Method string
+	// Path is relative to the API version, such as /channels/123/messages.
+	Path    string
+	Body    []byte
+	Headers http.Header
+}
+
+// RESTResponse is the response to a RESTRequest.
+type RESTResponse struct {
+	StatusCode int
+	Headers    http.Header
+	Body       []byte
+}
+
+// RESTClient makes requests to the Discord REST API, waiting on the per-route and global
+// rate limits reported by Discord. It is shared by every application, and limits are tracked per bot token.
+type RESTClient struct {
+	client  *http.Client
+	baseURL string
+
+	buckets *bucketstore.BucketStore
+
+	// routes maps a route to the rate limit bucket Discord reported for it.
+	routes *syncmap.Map[string, string]
+}
+
+// NewRESTClient creates a RESTClient that sends requests with the client, or http.DefaultClient when nil.
+func NewRESTClient(client *http.Client) *RESTClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &RESTClient{
+		client:  client,
+		baseURL: discord.EndpointDiscord + "/api/" + discord.APIVersion,
+
+		buckets: bucketstore.NewBucketStore(),
+
+		routes: syncmap.NewSyncMap[string, string](),
+	}
+}
+
+// Do sends a request using a bot token. Requests wait for the rate limits of their route and the
+// token, and rate limited responses are retried. A rate limited response is returned if it is
+// still limited after RESTMaxAttempts or asks to wait longer than RESTMaxRetryAfter.
+func (restClient *RESTClient) Do(ctx context.Context, botToken string, restRequest *RESTRequest) (*RESTResponse, error) {
+	route, majorParameter := restRoute(restRequest.Method, restRequest.Path)
+	tokenHash := identifyTokenHash(botToken)
+
+	globalBucket := "rest:" + tokenHash + ":global"
+
+	var restResponse *RESTResponse
+
+	for attempt := 1; attempt <= RESTMaxAttempts; attempt++ {
+		routeBucket := restClient.routeBucket(tokenHash, route, majorParameter)
+
+		if err := restClient.buckets.CreateWaitForBucket(globalBucket, RESTGlobalLimit, time.Second); err != nil {
+			return nil, fmt.Errorf("failed to wait for global bucket: %w", err)
+		}
+
+		// Routes without a known limit are not limited until Discord reports one.
+		if err := restClient.buckets.CreateWaitForBucket(routeBucket, 1, 0); err != nil {
+			return nil, fmt.Errorf("failed to wait for route bucket: %w", err)
+		}
+
+		var err error
+
+		restResponse, err = restClient.do(ctx, botToken, restRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		restClient.updateBuckets(restResponse.Headers, tokenHash, route, majorParameter)
+
+		if restResponse.StatusCode != http.StatusTooManyRequests {
+			return restResponse, nil
+		}
+
+		retryAfter := restRetryAfter(restResponse)
+		if retryAfter > RESTMaxRetryAfter || attempt == RESTMaxAttempts {
+			break
+		}
+
+		if restResponse.Headers.Get("X-RateLimit-Global") == "true" {
+			restClient.buckets.UpdateBucket(globalBucket, RESTGlobalLimit, 0, retryAfter)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryAfter):
+		}
+	}
+
+	return restResponse, nil
+}
+
+func (restClient *RESTClient) do(ctx context.Context, botToken string, restRequest *RESTRequest) (*RESTResponse, error) {
+	requestURL := restClient.baseURL + restRequest.Path
+	if strings.HasPrefix(restRequest.Path, "/api/") {
+		requestURL = discord.EndpointDiscord + restRequest.Path
+	}
+
+	var body io.Reader
+	if len(restRequest.Body) > 0 {
+		body = bytes.NewReader(restRequest.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, restRequest.Method, requestURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for key, values := range restRequest.Headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if req.Header.Get("Content-Type") == "" && len(restRequest.Body) > 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	req.Header.Set("Authorization", "Bot "+botToken)
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := restClient.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to do request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(io.LimitReader(resp.Body, RESTMaxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return &RESTResponse{
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header,
+		Body:       responseBody,
+	}, nil
+}
+
+// routeBucket returns the name of the bucket a route is limited by.
+func (restClient *RESTClient) routeBucket(tokenHash, route, majorParameter string) string {
+	bucket, ok := restClient.routes.Load(tokenHash + ":" + route)
+	if !ok {
+		bucket = route
+	}
+
+	return "rest:" + tokenHash + ":" + bucket + ":" + majorParameter
+}
+
+// updateBuckets updates the bucket of a route from the rate limit headers of a response.
+func (restClient *RESTClient) updateBuckets(headers http.Header, tokenHash, route, majorParameter string) {
+	if bucket := headers.Get("X-RateLimit-Bucket"); bucket != "" {
+		restClient.routes.Store(tokenHash+":"+route, bucket)
+	}
+
+	limit, err := strconv.ParseInt(headers.Get("X-RateLimit-Limit"), 10, 32)
+	if err != nil {
+		return
+	}
+
+	remaining, err := strconv.ParseInt(headers.Get("X-RateLimit-Remaining"), 10, 32)
+	if err != nil {
+		return
+	}
+
+	resetAfter, err := strconv.ParseFloat(headers.Get("X-RateLimit-Reset-After"), 64)
+	if err != nil {
+		return
+	}
+
+	restClient.buckets.UpdateBucket(
+		restClient.routeBucket(tokenHash, route, majorParameter),
+		int32(limit),
+		int32(remaining),
+		time.Duration(resetAfter*float64(time.Second)),
+	)
+}
+
+// restRetryAfter returns how long a rate limited response asks to wait.
+func restRetryAfter(restResponse *RESTResponse) time.Duration {
+	var body struct {
+		RetryAfter float64 `json:"retry_after"`
+	}
+
+	if json.Unmarshal(restResponse.Body, &body) == nil && body.RetryAfter > 0 {
+		return time.Duration(body.RetryAfter * float64(time.Second))
+	}
+
+	if seconds, err := strconv.ParseFloat(restResponse.Headers.Get("Retry-After"), 64); err == nil && seconds > 0 {
+		return time.Duration(seconds * float64(time.Second))
+	}
+
+	return time.Second
+}
+
+// restRoute returns the route of a request, with IDs removed so requests to the same endpoint share it,
+// and the major parameter. Discord limits channels, guilds and webhooks separately.
+func restRoute(method, path string) (route, majorParameter string) {
+	path, _, _ = strings.Cut(path, "?")
+	path = strings.TrimPrefix(path, "/api/"+discord.APIVersion)
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for index, segment := range segments {
+		if index > 0 && segments[index-1] == "reactions" {
+			// Every reaction endpoint on a message shares a bucket.
+			segments = append(segments[:index], "*")
+
+			break
+		}
+
+		if !isSnowflake(segment) {
+			if index > 1 && segments[index-2] == "webhooks" {
+				// Webhook tokens are part of the major parameter.
+				majorParameter += "/" + segment
+				segments[index] = ":token"
+			}
+
+			continue
+		}
+
+		if index == 1 && (segments[0] == "channels" || segments[0] == "guilds" || segments[0] == "webhooks") {
+			majorParameter = segment
+		}
+
+		segments[index] = ":id"
+	}
+
+	return method + " /" + strings.Join(segments, "/"), majorParameter
+}
+
+func isSnowflake(segment string) bool {
+	if segment == "" {
+		return false
+	}
+
+	_, err := strconv.ParseUint(segment, 10, 64)
+
+	return err == nil
+}
@@ -0,0 +1,120 @@
+package sandwich_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRESTClient(t *testing.T, handler http.HandlerFunc) *sandwich.RESTClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	return sandwich.NewRESTClient(&http.Client{Transport: redirectTransport{target: target}})
+}
+
+func TestRESTClientDo(t *testing.T) {
+	t.Parallel()
+
+	restClient := newTestRESTClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v10/channels/123456789012345678/messages", r.URL.Path)
+		assert.Equal(t, "Bot token", r.Header.Get("Authorization"))
+		assert.Equal(t, "reason", r.Header.Get("X-Audit-Log-Reason"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.JSONEq(t, `{"content":"hello"}`, string(body))
+
+		w.Header().Set("X-Test", "value")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	})
+
+	restResponse, err := restClient.Do(context.Background(), "token", &sandwich.RESTRequest{
+		Method:  http.MethodPost,
+		Path:    "/channels/123456789012345678/messages",
+		Body:    []byte(`{"content":"hello"}`),
+		Headers: http.Header{"X-Audit-Log-Reason": []string{"reason"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, restResponse.StatusCode)
+	assert.Equal(t, "value", restResponse.Headers.Get("X-Test"))
+	assert.JSONEq(t, `{"id":"1"}`, string(restResponse.Body))
+}
+
+func TestRESTClientDoRetriesRateLimits(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	restClient := newTestRESTClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("X-RateLimit-Global", "true")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"You are being rate limited.","retry_after":0.01,"global":true}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	restResponse, err := restClient.Do(context.Background(), "token", &sandwich.RESTRequest{
+		Method: http.MethodGet,
+		Path:   "/users/@me",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, restResponse.StatusCode)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestRESTClientDoWaitsForBucket(t *testing.T) {
+	t.Parallel()
+
+	resetAfter := time.Millisecond * 200
+
+	restClient := newTestRESTClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Bucket", "abcd")
+		w.Header().Set("X-RateLimit-Limit", "1")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset-After", "0.2")
+
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	do := func(path string) {
+		_, err := restClient.Do(context.Background(), "token", &sandwich.RESTRequest{
+			Method: http.MethodGet,
+			Path:   path,
+		})
+		assert.NoError(t, err)
+	}
+
+	do("/channels/123456789012345678/messages/123456789012345679")
+
+	// Another channel has its own bucket.
+	start := time.Now()
+
+	do("/channels/223456789012345678/messages/123456789012345679")
+	assert.Less(t, time.Since(start), resetAfter)
+
+	// The same channel waits for the bucket to reset, even for a different message.
+	start = time.Now()
+
+	do("/channels/123456789012345678/messages/123456789012345680")
+	assert.GreaterOrEqual(t, time.Since(start), resetAfter/2)
+}
@@ -0,0 +1,139 @@
+package sandwich
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	// SecretReferenceEnvironment resolves a secret from an environment variable, such as env:DISCORD_TOKEN.
+	SecretReferenceEnvironment = "env:"
+	// SecretReferenceFile resolves a secret from the contents of a file, such as file:/run/secrets/discord_token.
+	SecretReferenceFile = "file:"
+)
+
+// RedactedSecret replaces secrets in API responses and logs.
+const RedactedSecret = "[REDACTED]"
+
+// SecretProvider resolves secret references, such as the bot token of an application, into their value.
+// This allows the configuration to only contain references so raw tokens are never saved.
+type SecretProvider interface {
+	ResolveSecret(ctx context.Context, reference string) (string, error)
+}
+
+// SecretProviderFromReferences resolves env: and file: references.
+// Any other value is treated as the secret itself.
+type SecretProviderFromReferences struct{}
+
+func NewSecretProviderFromReferences() *SecretProviderFromReferences {
+	return &SecretProviderFromReferences{}
+}
+
+func (p *SecretProviderFromReferences) ResolveSecret(_ context.Context, reference string) (string, error) {
+	switch {
+	case strings.HasPrefix(reference, SecretReferenceEnvironment):
+		name := strings.TrimPrefix(reference, SecretReferenceEnvironment)
+
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("%w: %s", ErrSecretNotFound, name)
+		}
+
+		return value, nil
+	case strings.HasPrefix(reference, SecretReferenceFile):
+		data, err := os.ReadFile(strings.TrimPrefix(reference, SecretReferenceFile))
+		if err != nil {
+			return "", fmt.Errorf("failed to read secret file: %w", err)
+		}
+
+		// Files written by secret managers commonly have a trailing newline.
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	return reference, nil
+}
+
+// SecretProviderInMemory resolves references from a map. This is intended for tests.
+type SecretProviderInMemory struct {
+	secretsMu sync.RWMutex
+	secrets   map[string]string
+}
+
+func NewSecretProviderInMemory(secrets map[string]string) *SecretProviderInMemory {
+	if secrets == nil {
+		secrets = make(map[string]string)
+	}
+
+	return &SecretProviderInMemory{
+		secretsMu: sync.RWMutex{},
+		secrets:   secrets,
+	}
+}
+
+// SetSecret adds or replaces the value of a reference.
+func (p *SecretProviderInMemory) SetSecret(reference, value string) {
+	p.secretsMu.Lock()
+	p.secrets[reference] = value
+	p.secretsMu.Unlock()
+}
+
+func (p *SecretProviderInMemory) ResolveSecret(_ context.Context, reference string) (string, error) {
+	p.secretsMu.RLock()
+	defer p.secretsMu.RUnlock()
+
+	value, ok := p.secrets[reference]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrSecretNotFound, reference)
+	}
+
+	return value, nil
+}
+
+// IsSecretReference returns true if the value refers to a secret instead of containing it.
+func IsSecretReference(value string) bool {
+	return strings.HasPrefix(value, SecretReferenceEnvironment) || strings.HasPrefix(value, SecretReferenceFile)
+}
+
+// redactSecret returns references as-is, as they do not contain the secret, and redacts anything else.
+func redactSecret(value string) string {
+	if value == "" || IsSecretReference(value) {
+		return value
+	}
+
+	return RedactedSecret
+}
+
+// LogValue redacts bot tokens when the configuration is logged.
+func (config Configuration) LogValue() slog.Value {
+	type configuration Configuration
+
+	redacted := configuration(config)
+	redacted.Applications = make([]*ApplicationConfiguration, 0, len(config.Applications))
+
+	for _, applicationConfig := range config.Applications {
+		if applicationConfig == nil {
+			continue
+		}
+
+		applicationCopy := *applicationConfig
+		applicationCopy.BotToken = redactSecret(applicationCopy.BotToken)
+
+		redacted.Applications = append(redacted.Applications, &applicationCopy)
+	}
+
+	return slog.AnyValue(redacted)
+}
+
+// LogValue redacts the bot token when the application configuration is logged.
+func (applicationConfig ApplicationConfiguration) LogValue() slog.Value {
+	type applicationConfiguration ApplicationConfiguration
+
+	redacted := applicationConfiguration(applicationConfig)
+	redacted.BotToken = redactSecret(redacted.BotToken)
+
+	return slog.AnyValue(redacted)
+}
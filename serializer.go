@@ -0,0 +1,405 @@
+package sandwich
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/WelcomerTeam/Discord/discord"
+	sandwich_protobuf "github.com/WelcomerTeam/Sandwich-Daemon/proto"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+// PayloadContentTypeHeader is the header producers set to the content type of the serializer, so consumers know how to decode events.
+const PayloadContentTypeHeader = "Content-Type"
+
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeMsgpack  = "application/msgpack"
+)
+
+// PayloadFormat is the name of a serializer in the configuration.
+type PayloadFormat string
+
+const (
+	PayloadFormatJSON     PayloadFormat = "json"
+	PayloadFormatProtobuf PayloadFormat = "protobuf"
+	PayloadFormatMsgpack  PayloadFormat = "msgpack"
+)
+
+// PayloadSerializer encodes produced payloads for consumers.
+type PayloadSerializer interface {
+	// ContentType is the MIME type of the encoded payloads.
+	ContentType() string
+	Marshal(payload *ProducedPayload) ([]byte, error)
+	Unmarshal(data []byte, payload *ProducedPayload) error
+}
+
+var (
+	// JSONPayloadSerializer encodes payloads as JSON, with the __metadata, __trace and __extra keys.
+	JSONPayloadSerializer PayloadSerializer = jsonPayloadSerializer{}
+	// ProtobufPayloadSerializer encodes payloads as the ProducedPayload protobuf message. The event data stays JSON.
+	ProtobufPayloadSerializer PayloadSerializer = protobufPayloadSerializer{}
+	// MsgpackPayloadSerializer encodes payloads as MessagePack, using the same keys as JSON. The event data is
+	// converted to MessagePack too, and the application ID is an integer.
+	MsgpackPayloadSerializer PayloadSerializer = msgpackPayloadSerializer{}
+)
+
+// NewPayloadSerializer returns the serializer of a format. An empty format is JSON.
+func NewPayloadSerializer(format PayloadFormat) (PayloadSerializer, error) {
+	switch format {
+	case "", PayloadFormatJSON:
+		return JSONPayloadSerializer, nil
+	case PayloadFormatProtobuf:
+		return ProtobufPayloadSerializer, nil
+	case PayloadFormatMsgpack:
+		return MsgpackPayloadSerializer, nil
+	default:
+		return nil, fmt.Errorf("unknown payload format %q, expected json, protobuf or msgpack", format)
+	}
+}
+
+// PayloadFrameMaxSize is the largest frame that ReadPayloadFrame reads.
+var PayloadFrameMaxSize = 64 * 1024 * 1024
+
+// marshalPayloadFrame encodes a payload for a stream of payloads. JSON payloads are followed by a newline,
+// and other formats are prefixed with their length as a 4 byte big endian integer.
+func marshalPayloadFrame(serializer PayloadSerializer, payload *ProducedPayload) ([]byte, error) {
+	data, err := serializer.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if serializer == JSONPayloadSerializer {
+		return append(data, '\n'), nil
+	}
+
+	if uint64(len(data)) > math.MaxUint32 {
+		return nil, fmt.Errorf("payload of %d bytes is too large to frame", len(data))
+	}
+
+	frame := make([]byte, 4, 4+len(data))
+	binary.BigEndian.PutUint32(frame, uint32(len(data)))
+
+	return append(frame, data...), nil
+}
+
+// ReadPayloadFrame reads the next payload from a stream written by a file or socket producer using the serializer.
+// Returns io.EOF once there are no more payloads.
+func ReadPayloadFrame(reader *bufio.Reader, serializer PayloadSerializer, payload *ProducedPayload) error {
+	if serializer == JSONPayloadSerializer {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(line) > 0 {
+				return io.ErrUnexpectedEOF
+			}
+
+			return err
+		}
+
+		return serializer.Unmarshal(line, payload)
+	}
+
+	var header [4]byte
+
+	if _, err := io.ReadFull(reader, header[:]); err != nil {
+		return err
+	}
+
+	size := binary.BigEndian.Uint32(header[:])
+	if uint64(size) > uint64(PayloadFrameMaxSize) {
+		return fmt.Errorf("frame of %d bytes is larger than %d bytes", size, PayloadFrameMaxSize)
+	}
+
+	data := make([]byte, size)
+
+	if _, err := io.ReadFull(reader, data); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+
+		return err
+	}
+
+	return serializer.Unmarshal(data, payload)
+}
+
+// JSON
+
+type jsonPayloadSerializer struct{}
+
+func (jsonPayloadSerializer) ContentType() string {
+	return ContentTypeJSON
+}
+
+func (jsonPayloadSerializer) Marshal(payload *ProducedPayload) ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+func (jsonPayloadSerializer) Unmarshal(data []byte, payload *ProducedPayload) error {
+	return json.Unmarshal(data, payload)
+}
+
+// Protobuf
+
+type protobufPayloadSerializer struct{}
+
+func (protobufPayloadSerializer) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+func (protobufPayloadSerializer) Marshal(payload *ProducedPayload) ([]byte, error) {
+	message := &sandwich_protobuf.ProducedPayload{
+		Op:       int32(payload.Op),
+		Data:     payload.Data,
+		Sequence: payload.Sequence,
+		Type:     payload.Type,
+		Metadata: &sandwich_protobuf.ProducedMetadata{
+			Identifier:    payload.Metadata.Identifier,
+			Application:   payload.Metadata.Application,
+			ApplicationId: int64(payload.Metadata.ApplicationID),
+			Shard:         payload.Metadata.Shard[:],
+		},
+	}
+
+	if len(payload.Extra) > 0 {
+		message.Extra = make(map[string][]byte, len(payload.Extra))
+
+		for key, value := range payload.Extra {
+			message.Extra[key] = value
+		}
+	}
+
+	if len(payload.Trace) > 0 {
+		message.Trace = make(map[string]int64, len(payload.Trace))
+
+		for key, value := range payload.Trace {
+			// Trace values are timestamps, anything else cannot be represented and is left out.
+			if timestamp, ok := traceTimestamp(value); ok {
+				message.Trace[key] = timestamp
+			}
+		}
+	}
+
+	return proto.Marshal(message)
+}
+
+func (protobufPayloadSerializer) Unmarshal(data []byte, payload *ProducedPayload) error {
+	var message sandwich_protobuf.ProducedPayload
+
+	if err := proto.Unmarshal(data, &message); err != nil {
+		return err
+	}
+
+	*payload = ProducedPayload{
+		GatewayPayload: discord.GatewayPayload{
+			Op:       discord.GatewayOp(message.GetOp()),
+			Data:     message.GetData(),
+			Sequence: message.GetSequence(),
+			Type:     message.GetType(),
+		},
+		Metadata: ProducedMetadata{
+			Identifier:    message.GetMetadata().GetIdentifier(),
+			Application:   message.GetMetadata().GetApplication(),
+			ApplicationID: discord.Snowflake(message.GetMetadata().GetApplicationId()),
+		},
+	}
+
+	copy(payload.Metadata.Shard[:], message.GetMetadata().GetShard())
+
+	if len(message.GetExtra()) > 0 {
+		payload.Extra = make(map[string]json.RawMessage, len(message.GetExtra()))
+
+		for key, value := range message.GetExtra() {
+			payload.Extra[key] = value
+		}
+	}
+
+	if len(message.GetTrace()) > 0 {
+		payload.Trace = make(Trace, len(message.GetTrace()))
+
+		for key, value := range message.GetTrace() {
+			payload.Trace[key] = value
+		}
+	}
+
+	return nil
+}
+
+func traceTimestamp(value any) (int64, bool) {
+	switch value := value.(type) {
+	case int64:
+		return value, true
+	case int:
+		return int64(value), true
+	case int32:
+		return int64(value), true
+	case uint64:
+		return int64(value), true
+	case float64:
+		return int64(value), true
+	case json.Number:
+		timestamp, err := value.Int64()
+
+		return timestamp, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// MessagePack
+
+type msgpackPayloadSerializer struct{}
+
+type msgpackPayload struct {
+	Op       discord.GatewayOp `msgpack:"op"`
+	Data     any               `msgpack:"d"`
+	Sequence int32             `msgpack:"s"`
+	Type     string            `msgpack:"t"`
+	Extra    map[string]any    `msgpack:"__extra,omitempty"`
+	Metadata msgpackMetadata   `msgpack:"__metadata"`
+	Trace    Trace             `msgpack:"__trace,omitempty"`
+}
+
+type msgpackMetadata struct {
+	Identifier    string   `msgpack:"i"`
+	Application   string   `msgpack:"a"`
+	ApplicationID int64    `msgpack:"id"`
+	Shard         [3]int32 `msgpack:"s"`
+}
+
+func (msgpackPayloadSerializer) ContentType() string {
+	return ContentTypeMsgpack
+}
+
+func (msgpackPayloadSerializer) Marshal(payload *ProducedPayload) ([]byte, error) {
+	data, err := msgpackValueFromJSON(payload.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert data: %w", err)
+	}
+
+	message := msgpackPayload{
+		Op:       payload.Op,
+		Data:     data,
+		Sequence: payload.Sequence,
+		Type:     payload.Type,
+		Metadata: msgpackMetadata{
+			Identifier:    payload.Metadata.Identifier,
+			Application:   payload.Metadata.Application,
+			ApplicationID: int64(payload.Metadata.ApplicationID),
+			Shard:         payload.Metadata.Shard,
+		},
+		Trace: payload.Trace,
+	}
+
+	if len(payload.Extra) > 0 {
+		message.Extra = make(map[string]any, len(payload.Extra))
+
+		for key, value := range payload.Extra {
+			if message.Extra[key], err = msgpackValueFromJSON(value); err != nil {
+				return nil, fmt.Errorf("failed to convert extra %q: %w", key, err)
+			}
+		}
+	}
+
+	return msgpack.Marshal(&message)
+}
+
+func (msgpackPayloadSerializer) Unmarshal(data []byte, payload *ProducedPayload) error {
+	var message msgpackPayload
+
+	// Loose decoding returns every integer as int64 or uint64, rather than the smallest type that fits.
+	decoder := msgpack.NewDecoder(bytes.NewReader(data))
+	decoder.UseLooseInterfaceDecoding(true)
+
+	if err := decoder.Decode(&message); err != nil {
+		return err
+	}
+
+	*payload = ProducedPayload{
+		GatewayPayload: discord.GatewayPayload{
+			Op:       message.Op,
+			Sequence: message.Sequence,
+			Type:     message.Type,
+		},
+		Metadata: ProducedMetadata{
+			Identifier:    message.Metadata.Identifier,
+			Application:   message.Metadata.Application,
+			ApplicationID: discord.Snowflake(message.Metadata.ApplicationID),
+			Shard:         message.Metadata.Shard,
+		},
+		Trace: message.Trace,
+	}
+
+	var err error
+
+	if message.Data != nil {
+		if payload.Data, err = json.Marshal(message.Data); err != nil {
+			return fmt.Errorf("failed to convert data: %w", err)
+		}
+	}
+
+	if len(message.Extra) > 0 {
+		payload.Extra = make(map[string]json.RawMessage, len(message.Extra))
+
+		for key, value := range message.Extra {
+			if payload.Extra[key], err = json.Marshal(value); err != nil {
+				return fmt.Errorf("failed to convert extra %q: %w", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// msgpackValueFromJSON decodes JSON into values that MessagePack can encode, keeping integers as integers.
+func msgpackValueFromJSON(data json.RawMessage) (any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return convertJSONNumbers(value), nil
+}
+
+func convertJSONNumbers(value any) any {
+	switch value := value.(type) {
+	case json.Number:
+		if integer, err := value.Int64(); err == nil {
+			return integer
+		}
+
+		float, _ := value.Float64()
+
+		return float
+	case map[string]any:
+		for key, element := range value {
+			value[key] = convertJSONNumbers(element)
+		}
+
+		return value
+	case []any:
+		for index, element := range value {
+			value[index] = convertJSONNumbers(element)
+		}
+
+		return value
+	default:
+		return value
+	}
+}
@@ -0,0 +1,97 @@
+package sandwich_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/WelcomerTeam/Discord/discord"
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func testProducedPayload() *sandwich.ProducedPayload {
+	return &sandwich.ProducedPayload{
+		GatewayPayload: discord.GatewayPayload{
+			Op:       discord.GatewayOpDispatch,
+			Data:     json.RawMessage(`{"id":"330416853971107840","type":0,"flags":1.5,"mentions":[],"author":{"bot":true}}`),
+			Sequence: 42,
+			Type:     "MESSAGE_CREATE",
+		},
+		Extra: map[string]json.RawMessage{
+			"before": json.RawMessage(`{"content":"hello"}`),
+		},
+		Metadata: sandwich.ProducedMetadata{
+			Identifier:    "welcomer",
+			Application:   "welcomer",
+			ApplicationID: 330416853971107840,
+			Shard:         [3]int32{0, 1, 2},
+		},
+		Trace: sandwich.Trace{
+			"dispatch": int64(1760000000000000000),
+			"publish":  int64(1760000000000000100),
+		},
+	}
+}
+
+func TestPayloadSerializersRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []sandwich.PayloadFormat{
+		sandwich.PayloadFormatJSON,
+		sandwich.PayloadFormatProtobuf,
+		sandwich.PayloadFormatMsgpack,
+	} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			serializer, err := sandwich.NewPayloadSerializer(format)
+			assert.NoError(t, err)
+
+			payload := testProducedPayload()
+
+			data, err := serializer.Marshal(payload)
+			assert.NoError(t, err)
+
+			var decoded sandwich.ProducedPayload
+
+			assert.NoError(t, serializer.Unmarshal(data, &decoded))
+
+			assert.Equal(t, payload.Op, decoded.Op)
+			assert.Equal(t, payload.Sequence, decoded.Sequence)
+			assert.Equal(t, payload.Type, decoded.Type)
+			assert.Equal(t, payload.Metadata, decoded.Metadata)
+			assert.JSONEq(t, string(payload.Data), string(decoded.Data))
+			assert.JSONEq(t, string(payload.Extra["before"]), string(decoded.Extra["before"]))
+
+			// JSON decodes trace values as numbers, which are compared as JSON.
+			expectedTrace, _ := json.Marshal(payload.Trace)
+			decodedTrace, _ := json.Marshal(decoded.Trace)
+			assert.JSONEq(t, string(expectedTrace), string(decodedTrace))
+		})
+	}
+
+	_, err := sandwich.NewPayloadSerializer("xml")
+	assert.Error(t, err)
+}
+
+func TestMsgpackPayloadSerializerKeepsIntegers(t *testing.T) {
+	t.Parallel()
+
+	data, err := sandwich.MsgpackPayloadSerializer.Marshal(testProducedPayload())
+	assert.NoError(t, err)
+
+	// Consumers decode events generically with the same keys as JSON.
+	var decoded map[string]any
+
+	assert.NoError(t, msgpack.Unmarshal(data, &decoded))
+	assert.Equal(t, "MESSAGE_CREATE", decoded["t"])
+
+	eventData, ok := decoded["d"].(map[string]any)
+	assert.True(t, ok)
+	assert.EqualValues(t, 0, eventData["type"])
+
+	_, isFloat := eventData["type"].(float64)
+	assert.False(t, isFloat)
+	assert.InDelta(t, 1.5, eventData["flags"], 0)
+}
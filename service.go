@@ -3,10 +3,12 @@ package sandwich
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -18,6 +20,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -28,14 +32,18 @@ type Sandwich struct {
 
 	configProvider ConfigProvider
 	Config         *atomic.Pointer[Configuration]
+	// reconcileMu serializes configuration reloads, so applications are not reconciled twice at once.
+	reconcileMu sync.Mutex
 
 	eventProvider    EventProvider
 	identifyProvider IdentifyProvider
 	producerProvider ProducerProvider
 	stateProvider    StateProvider
 	dedupeProvider   DedupeProvider
+	secretProvider   SecretProvider
 
 	Client *http.Client
+	REST   *RESTClient
 
 	gatewayLimiter  *limiter.DurationLimiter
 	identifyBuckets *bucketstore.BucketStore
@@ -46,8 +54,24 @@ type Sandwich struct {
 
 	panicHandler PanicHandler
 
-	listenerCounter *atomic.Int32
-	listeners       *syncmap.Map[int32, chan *listenerData]
+	eventMiddlewares []EventMiddleware
+
+	producerTypes map[string]ProducerFactory
+
+	listenerCounter        *atomic.Int32
+	listeners              *syncmap.Map[int32, *Listener]
+	listenerBufferSize     int
+	listenerOverflowPolicy ListenerOverflowPolicy
+	broadcastSequence      *atomic.Int64
+
+	readinessPolicy *atomic.Pointer[ReadinessPolicy]
+	lastHealthTick  *atomic.Pointer[time.Time]
+	healthServer    *atomic.Pointer[health.Server]
+
+	authenticator *atomic.Pointer[Authenticator]
+
+	httpServersMu sync.Mutex
+	httpServers   map[*http.Server]*http.ServeMux
 }
 
 type PanicHandler func(sandwich *Sandwich, r any)
@@ -71,14 +95,17 @@ func NewSandwich(logger *slog.Logger, configProvider ConfigProvider, client *htt
 
 		configProvider: configProvider,
 		Config:         &atomic.Pointer[Configuration]{},
+		reconcileMu:    sync.Mutex{},
 
 		eventProvider:    eventProvider,
 		identifyProvider: identifyProvider,
 		producerProvider: producerProvider,
 		stateProvider:    stateProvider,
 		dedupeProvider:   dedupeProvider,
+		secretProvider:   NewSecretProviderFromReferences(),
 
 		Client: client,
+		REST:   NewRESTClient(client),
 
 		gatewayLimiter:  limiter.NewDurationLimiter(1, time.Second),
 		identifyBuckets: bucketstore.NewBucketStore(),
@@ -89,13 +116,41 @@ func NewSandwich(logger *slog.Logger, configProvider ConfigProvider, client *htt
 
 		panicHandler: nil,
 
-		listenerCounter: &atomic.Int32{},
-		listeners:       syncmap.NewSyncMap[int32, chan *listenerData](),
+		eventMiddlewares: nil,
+
+		producerTypes: map[string]ProducerFactory{
+			WebhookProducerType: newWebhookProducerFactory(&http.Client{}),
+			FileProducerType:    fileProducerFactory,
+			SocketProducerType:  socketProducerFactory,
+		},
+
+		listenerCounter:        &atomic.Int32{},
+		listeners:              syncmap.NewSyncMap[int32, *Listener](),
+		listenerBufferSize:     DefaultListenerBufferSize,
+		listenerOverflowPolicy: ListenerOverflowDropOldest,
+		broadcastSequence:      &atomic.Int64{},
+
+		readinessPolicy: &atomic.Pointer[ReadinessPolicy]{},
+		lastHealthTick:  &atomic.Pointer[time.Time]{},
+		healthServer:    &atomic.Pointer[health.Server]{},
+
+		authenticator: &atomic.Pointer[Authenticator]{},
+
+		httpServersMu: sync.Mutex{},
+		httpServers:   make(map[*http.Server]*http.ServeMux),
 	}
 
+	sandwich.readinessPolicy.Store(&ReadinessPolicy{
+		Mode:                   ReadinessAutoStartApplications,
+		MinimumShardPercentage: 100,
+	})
+
 	// Start background cleanup for completed guild chunks
 	go sandwich.cleanupGuildChunks(context.Background())
 
+	// Start the health loop used for liveness and the gRPC health service
+	go sandwich.runHealthLoop(context.Background())
+
 	return sandwich
 }
 
@@ -105,6 +160,28 @@ func (sandwich *Sandwich) WithPanicHandler(panicHandler PanicHandler) *Sandwich
 	return sandwich
 }
 
+// WithSecretProvider configures the provider used to resolve bot token references.
+func (sandwich *Sandwich) WithSecretProvider(secretProvider SecretProvider) *Sandwich {
+	sandwich.secretProvider = secretProvider
+
+	return sandwich
+}
+
+// WithListenerOptions configures the buffer size and overflow policy used for new listeners.
+// Unknown policies fall back to ListenerOverflowDropOldest.
+func (sandwich *Sandwich) WithListenerOptions(bufferSize int, policy ListenerOverflowPolicy) *Sandwich {
+	if !policy.Valid() {
+		sandwich.Logger.Warn("Unknown listener overflow policy, using DropOldest", "policy", policy.String())
+
+		policy = ListenerOverflowDropOldest
+	}
+
+	sandwich.listenerBufferSize = bufferSize
+	sandwich.listenerOverflowPolicy = policy
+
+	return sandwich
+}
+
 func (sandwich *Sandwich) WithPrometheusAnalytics(
 	server *http.Server,
 	registry *prometheus.Registry,
@@ -116,11 +193,34 @@ func (sandwich *Sandwich) WithPrometheusAnalytics(
 
 	registry.MustRegister(
 		EventMetrics.EventsTotal,
+		EventMetrics.FilteredEvents,
+		EventMetrics.PublishedBytes,
 		EventMetrics.GatewayLatency,
 
 		ShardMetrics.ApplicationStatus,
 		ShardMetrics.ShardStatus,
 
+		GatewayMetrics.SessionStartRemaining,
+		GatewayMetrics.SessionStartTotal,
+		GatewayMetrics.SessionStartResetAfter,
+
+		ListenerMetrics.DroppedMessages,
+
+		ProducerMetrics.RoutePublished,
+		ProducerMetrics.RouteErrors,
+		ProducerMetrics.RoutePublishDuration,
+		ProducerMetrics.Retries,
+		ProducerMetrics.Failures,
+		ProducerMetrics.DeadLetterBytes,
+		ProducerMetrics.DeadLetterEvents,
+		ProducerMetrics.WebhookRequests,
+
+		SpoolMetrics.LagBytes,
+		SpoolMetrics.EventAge,
+		SpoolMetrics.Paused,
+		SpoolMetrics.Forwarded,
+		SpoolMetrics.Rejected,
+
 		StateMetrics.StateRequests,
 		StateMetrics.StateHits,
 		StateMetrics.StateMisses,
@@ -134,13 +234,31 @@ func (sandwich *Sandwich) WithPrometheusAnalytics(
 		StateMetrics.VoiceStates,
 	)
 
-	mux := http.NewServeMux()
+	mux := sandwich.serveHTTP(server, "Prometheus")
 	mux.Handle("/metrics", promhttp.HandlerFor(registry, opts))
+	mux.HandleFunc("/healthz", sandwich.handleHealthz)
+	mux.HandleFunc("/readyz", sandwich.handleReadyz)
+
+	return sandwich
+}
+
+// serveHTTP returns the ServeMux of a server, starting the server if it has not already been started
+// by WithPrometheusAnalytics or WithHTTPAPI. This allows both to share a server in any order.
+func (sandwich *Sandwich) serveHTTP(server *http.Server, name string) *http.ServeMux {
+	sandwich.httpServersMu.Lock()
+	defer sandwich.httpServersMu.Unlock()
 
+	if mux, ok := sandwich.httpServers[server]; ok {
+		return mux
+	}
+
+	mux := http.NewServeMux()
 	server.Handler = mux
 
+	sandwich.httpServers[server] = mux
+
 	go func() {
-		slog.Info("Starting Prometheus HTTP server", "host", server.Addr)
+		slog.Info("Starting "+name+" HTTP server", "host", server.Addr)
 
 		var err error
 
@@ -151,16 +269,19 @@ func (sandwich *Sandwich) WithPrometheusAnalytics(
 		}
 
 		if err != nil {
-			panic(fmt.Errorf("failed to start Prometheus HTTP server: %w", err))
+			panic(fmt.Errorf("failed to start %s HTTP server: %w", name, err))
 		}
 	}()
 
-	return sandwich
+	return mux
 }
 
 func (sandwich *Sandwich) WithGRPCServer(listenerConfig *net.ListenConfig, network, address string, server *grpc.Server) *Sandwich {
 	pb.RegisterSandwichServer(server, sandwich.NewGRPCServer())
 
+	// Registers the standard health service, which reports readiness
+	healthpb.RegisterHealthServer(server, sandwich.registerGRPCHealth())
+
 	// Enables server reflection
 	reflection.Register(server)
 
@@ -202,17 +323,11 @@ func (sandwich *Sandwich) Start(ctx context.Context) error {
 
 	sandwich.startApplications(ctx)
 
-	return nil
-}
-
-func (sandwich *Sandwich) Stop(ctx context.Context) {
-	sandwich.Logger.Info("Stopping Sandwich")
-
-	sandwich.Applications.Range(func(_ string, application *Application) bool {
-		application.Stop(ctx)
+	if configWatcher, ok := sandwich.configProvider.(ConfigWatcher); ok {
+		go sandwich.watchConfig(ctx, configWatcher)
+	}
 
-		return true
-	})
+	return nil
 }
 
 func (sandwich *Sandwich) getConfig(ctx context.Context) error {
@@ -223,13 +338,17 @@ func (sandwich *Sandwich) getConfig(ctx context.Context) error {
 		return fmt.Errorf("failed to get config: %w", err)
 	}
 
+	if err := ValidateConfiguration(config); err != nil {
+		return err
+	}
+
 	sandwich.Config.Store(config)
 
 	// Update application configurations
 	for _, applicationConfig := range config.Applications {
 		if application, ok := sandwich.Applications.Load(applicationConfig.ApplicationIdentifier); ok {
 			slog.Info("Updated application configuration", "application_identifier", applicationConfig.ApplicationIdentifier)
-			application.Configuration.Store(applicationConfig)
+			application.storeConfiguration(applicationConfig)
 		}
 	}
 
@@ -284,38 +403,15 @@ func (sandwich *Sandwich) AddApplication(ctx context.Context, applicationConfig
 	return application, nil
 }
 
-// validateApplicationConfig validates a application configuration.
+// validateApplicationConfig validates a application configuration that is being added.
 func (sandwich *Sandwich) validateApplicationConfig(applicationConfig *ApplicationConfiguration) error {
-	if applicationConfig.ApplicationIdentifier == "" {
-		return ErrApplicationMissingIdentifier
-	}
-
-	if applicationConfig.BotToken == "" {
-		return ErrApplicationMissingBotToken
-	}
+	errs := validateApplicationConfiguration(applicationConfig.ApplicationIdentifier, applicationConfig)
 
 	if _, ok := sandwich.Applications.Load(applicationConfig.ApplicationIdentifier); ok {
-		return ErrApplicationIdentifierExists
+		errs.add(applicationConfig.ApplicationIdentifier+".application_identifier", ErrApplicationIdentifierExists)
 	}
 
-	return nil
-}
-
-type listenerData struct {
-	timestamp time.Time
-	payload   []byte
-}
-
-func (sandwich *Sandwich) AddListener(listener chan *listenerData) int32 {
-	counter := sandwich.listenerCounter.Add(1)
-
-	sandwich.listeners.Store(counter, listener)
-
-	return counter
-}
-
-func (sandwich *Sandwich) RemoveListener(counter int32) {
-	sandwich.listeners.Delete(counter)
+	return errs.orNil()
 }
 
 func (sandwich *Sandwich) Broadcast(eventType string, data any) error {
@@ -342,11 +438,13 @@ func (sandwich *Sandwich) Broadcast(eventType string, data any) error {
 
 	listenData := &listenerData{
 		timestamp: time.Now(),
+		sequence:  sandwich.broadcastSequence.Add(1),
 		payload:   payloadBytes,
 	}
 
-	sandwich.listeners.Range(func(_ int32, listener chan *listenerData) bool {
-		listener <- listenData
+	// Listeners are buffered and never block, so a slow listener cannot stall the broadcaster.
+	sandwich.listeners.Range(func(_ int32, listener *Listener) bool {
+		listener.push(listenData)
 
 		return true
 	})
@@ -356,9 +454,19 @@ func (sandwich *Sandwich) Broadcast(eventType string, data any) error {
 
 // Custom conversions
 
-func applicationToPB(application *Application) *pb.SandwichApplication {
+// applicationToPB converts an application. The bot token is redacted unless includeBotToken is true.
+func applicationToPB(application *Application, includeBotToken bool) *pb.SandwichApplication {
 	configuration := application.Configuration.Load()
 
+	botToken := redactSecret(configuration.BotToken)
+
+	if includeBotToken {
+		botToken = application.BotToken()
+		if botToken == "" {
+			botToken = configuration.BotToken
+		}
+	}
+
 	var userID int64
 
 	if applicationUser := application.User.Load(); applicationUser != nil {
@@ -416,7 +524,7 @@ func applicationToPB(application *Application) *pb.SandwichApplication {
 		ApplicationIdentifier: configuration.ApplicationIdentifier,
 		ProducerIdentifier:    configuration.ProducerIdentifier,
 		DisplayName:           configuration.DisplayName,
-		BotToken:              configuration.BotToken,
+		BotToken:              botToken,
 		ShardCount:            application.ShardCount.Load(),
 		AutoSharded:           configuration.AutoSharded,
 		Status:                application.Status.Load(),
@@ -427,6 +535,72 @@ func applicationToPB(application *Application) *pb.SandwichApplication {
 	}
 }
 
+func configurationChangeReportToPB(report *ConfigurationChangeReport) *pb.ReconcileConfigurationResponse {
+	changes := make([]*pb.ConfigurationChange, 0, len(report.Changes))
+
+	for _, change := range report.Changes {
+		changes = append(changes, &pb.ConfigurationChange{
+			ApplicationIdentifier: change.ApplicationIdentifier,
+			Action:                string(change.Action),
+			Fields:                change.Fields,
+			Error:                 change.Error,
+		})
+	}
+
+	return &pb.ReconcileConfigurationResponse{
+		BaseResponse: &pb.BaseResponse{
+			Ok:    true,
+			Error: "",
+		},
+		DryRun:  report.DryRun,
+		Changes: changes,
+	}
+}
+
+func configVersionsToPB(versions []ConfigVersion) *pb.ListConfigVersionsResponse {
+	versionsPB := make([]*pb.ConfigVersion, 0, len(versions))
+
+	for _, version := range versions {
+		versionsPB = append(versionsPB, &pb.ConfigVersion{
+			Version:   version.Version,
+			Timestamp: version.Timestamp.Unix(),
+			Reason:    version.Reason,
+			Size:      version.Size,
+		})
+	}
+
+	return &pb.ListConfigVersionsResponse{
+		BaseResponse: &pb.BaseResponse{
+			Ok:    true,
+			Error: "",
+		},
+		Versions: versionsPB,
+	}
+}
+
+func configurationErrorsToPB(err error) *pb.ValidateConfigurationResponse {
+	var configurationErrors ConfigurationErrors
+
+	errs := make([]*pb.ConfigurationError, 0)
+
+	if errors.As(err, &configurationErrors) {
+		for _, configurationError := range configurationErrors {
+			errs = append(errs, &pb.ConfigurationError{
+				Field:   configurationError.Field,
+				Message: configurationError.Err.Error(),
+			})
+		}
+	}
+
+	return &pb.ValidateConfigurationResponse{
+		BaseResponse: &pb.BaseResponse{
+			Ok:    false,
+			Error: err.Error(),
+		},
+		Errors: errs,
+	}
+}
+
 // cleanupGuildChunks periodically removes completed guild chunks to prevent memory leaks
 func (sandwich *Sandwich) cleanupGuildChunks(ctx context.Context) {
 	ticker := time.NewTicker(5 * time.Minute)
@@ -358,9 +358,12 @@ func (shard *Shard) Stop(ctx context.Context, code websocket.StatusCode) {
 
 	shard.SetStatus(ShardStatusStopping)
 
-	shard.stop <- struct{}{}
+	// The producer is shared between shards, so it is closed by the application.
+	select {
+	case shard.stop <- struct{}{}:
+	default:
+	}
 
-	shard.Application.producer.Close()
 	shard.closeWS(ctx, code)
 
 	shard.SetStatus(ShardStatusStopped)
@@ -372,6 +375,10 @@ func (shard *Shard) Listen(ctx context.Context) error {
 	websocketConn := shard.websocketConn
 
 	for {
+		if err := shard.waitForReads(ctx); err != nil {
+			return err
+		}
+
 		msg, err := shard.read(ctx, websocketConn)
 
 		select {
@@ -599,21 +606,24 @@ func (shard *Shard) identify(ctx context.Context) error {
 
 	shard.Logger.Debug("Shard is identifying", "shard_id", shard.ShardID, "shard_count", shardCount)
 
-	shard.Application.gatewaySessionStartLimitRemaining.Add(-1)
+	remaining := shard.Application.gatewaySessionStartLimitRemaining.Add(-1)
+	UpdateSessionStartRemaining(shard.Application.Identifier, remaining)
 
 	err := shard.waitForIdentify(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to wait for identify: %w", err)
 	}
 
+	presence := shard.presence()
+
 	return shard.SendEvent(ctx, discord.GatewayOpIdentify, discord.Identify{
 		Properties: discord.IdentifyProperties{
 			OS:      runtime.GOOS,
 			Browser: "Sandwich " + Version,
 			Device:  "Sandwich " + Version,
 		},
-		Presence:       &configuration.DefaultPresence,
-		Token:          configuration.BotToken,
+		Presence:       &presence,
+		Token:          shard.Application.BotToken(),
 		Shard:          [2]int32{shard.ShardID, shardCount},
 		LargeThreshold: GatewayLargeThreshold,
 		Intents:        configuration.Intents,
@@ -635,10 +645,8 @@ func (shard *Shard) waitForIdentify(ctx context.Context) error {
 func (shard *Shard) resume(ctx context.Context) error {
 	shard.Logger.Debug("Shard is resuming")
 
-	configuration := shard.Application.Configuration.Load()
-
 	return shard.SendEvent(ctx, discord.GatewayOpResume, discord.Resume{
-		Token:     configuration.BotToken,
+		Token:     shard.Application.BotToken(),
 		SessionID: *shard.sessionID.Load(),
 		Sequence:  shard.sequence.Load(),
 	})
@@ -672,7 +680,12 @@ func (shard *Shard) send(ctx context.Context, gatewayOp discord.GatewayOp, data
 		shard.websocketRatelimit.Lock()
 	}
 
-	shard.Logger.Debug("Sending payload", "payload", string(payload))
+	// Identify and resume payloads contain the bot token so are not logged.
+	if gatewayOp == discord.GatewayOpIdentify || gatewayOp == discord.GatewayOpResume {
+		shard.Logger.Debug("Sending payload", "op", gatewayOp, "payload", RedactedSecret)
+	} else {
+		shard.Logger.Debug("Sending payload", "payload", string(payload))
+	}
 
 	err = shard.websocketConn.Write(ctx, websocket.MessageText, payload)
 	if err != nil {
@@ -718,6 +731,9 @@ func (shard *Shard) OnEvent(ctx context.Context, msg *discord.GatewayPayload, tr
 }
 
 func (shard *Shard) OnDispatch(ctx context.Context, msg *discord.GatewayPayload, trace *Trace) error {
+	shard.Application.dispatchesInFlight.Add(1)
+	defer shard.Application.dispatchesInFlight.Add(-1)
+
 	defer func() {
 		if r := recover(); r != nil {
 			if shard.Sandwich.panicHandler != nil {
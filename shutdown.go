@@ -0,0 +1,210 @@
+package sandwich
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+// DispatchDrainPollInterval is how often in-flight dispatches are checked when draining.
+var DispatchDrainPollInterval = time.Millisecond * 10
+
+// Flusher is implemented by producers and providers that buffer data and should persist it before closing.
+type Flusher interface {
+	Flush(ctx context.Context) error
+}
+
+// ShutdownReport describes what happened when Sandwich was stopped.
+type ShutdownReport struct {
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+
+	// DeadlineExceeded is true if the context ended before everything was drained.
+	DeadlineExceeded bool `json:"deadline_exceeded"`
+
+	Applications map[string]*ApplicationShutdownReport `json:"applications"`
+
+	// Errors from flushing providers.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Dropped returns the total number of dispatches that were still in flight when the deadline was reached.
+func (report *ShutdownReport) Dropped() int64 {
+	var dropped int64
+
+	for _, applicationReport := range report.Applications {
+		dropped += applicationReport.DispatchesDropped
+	}
+
+	return dropped
+}
+
+// ApplicationShutdownReport describes what happened when an application was stopped.
+type ApplicationShutdownReport struct {
+	ShardsStopped int `json:"shards_stopped"`
+
+	// DispatchesDrained is the number of dispatches that were in flight and completed while stopping.
+	DispatchesDrained int64 `json:"dispatches_drained"`
+	// DispatchesDropped is the number of dispatches that were still in flight when the deadline was reached.
+	DispatchesDropped int64 `json:"dispatches_dropped"`
+
+	ProducerFlushed bool `json:"producer_flushed"`
+	ProducerClosed  bool `json:"producer_closed"`
+
+	Errors []string `json:"errors,omitempty"`
+}
+
+func (report *ApplicationShutdownReport) addError(err error) {
+	report.Errors = append(report.Errors, err.Error())
+}
+
+// Stop gracefully stops every application. Shards stop reading from their sockets, in-flight dispatches
+// are drained, producers are flushed and closed and providers are flushed. If the context ends first,
+// anything that has not completed is reported as dropped.
+func (sandwich *Sandwich) Stop(ctx context.Context) *ShutdownReport {
+	sandwich.Logger.Info("Stopping Sandwich")
+
+	report := &ShutdownReport{
+		StartedAt:    time.Now(),
+		Applications: make(map[string]*ApplicationShutdownReport),
+	}
+
+	var reportMu sync.Mutex
+
+	var wg sync.WaitGroup
+
+	// Applications are stopped in parallel so they share the deadline.
+	sandwich.Applications.Range(func(identifier string, application *Application) bool {
+		wg.Go(func() {
+			applicationReport := application.Shutdown(ctx)
+
+			reportMu.Lock()
+			report.Applications[identifier] = applicationReport
+			reportMu.Unlock()
+		})
+
+		return true
+	})
+
+	wg.Wait()
+
+	// Persist anything that the providers have buffered.
+	for _, provider := range []any{
+		sandwich.stateProvider,
+		sandwich.dedupeProvider,
+		sandwich.identifyProvider,
+		sandwich.configProvider,
+	} {
+		if flusher, ok := provider.(Flusher); ok {
+			if err := flusher.Flush(ctx); err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("failed to flush %T: %s", provider, err))
+			}
+		}
+	}
+
+	report.FinishedAt = time.Now()
+	report.DeadlineExceeded = ctx.Err() != nil
+
+	sandwich.Logger.Info("Stopped Sandwich",
+		"duration", report.FinishedAt.Sub(report.StartedAt),
+		"dropped", report.Dropped(),
+		"deadline_exceeded", report.DeadlineExceeded,
+	)
+
+	return report
+}
+
+// Shutdown gracefully stops the application and reports what was drained and dropped.
+func (application *Application) Shutdown(ctx context.Context) *ApplicationShutdownReport {
+	application.SetStatus(ApplicationStatusStopping)
+
+	report := &ApplicationShutdownReport{}
+
+	application.stopPresenceRotation()
+
+	// Stop reading from the sockets so no new dispatches start.
+	application.Shards.Range(func(_ int32, shard *Shard) bool {
+		shard.Stop(ctx, websocket.StatusNormalClosure)
+
+		report.ShardsStopped++
+
+		return true
+	})
+
+	inFlight := application.dispatchesInFlight.Load()
+	remaining := application.waitForDispatches(ctx)
+
+	report.DispatchesDrained = max(inFlight-remaining, 0)
+	report.DispatchesDropped = remaining
+
+	if remaining > 0 {
+		application.Logger.Warn("Dispatches were still in flight when stopping", "dropped", remaining)
+	}
+
+	if producer := application.producer; producer != nil {
+		if flusher, ok := producer.(Flusher); ok {
+			if err := flusher.Flush(ctx); err != nil {
+				report.addError(fmt.Errorf("failed to flush producer: %w", err))
+			} else {
+				report.ProducerFlushed = true
+			}
+		}
+
+		closed, err := application.closeProducer()
+		if err != nil {
+			report.addError(fmt.Errorf("failed to close producer: %w", err))
+		}
+
+		report.ProducerClosed = closed
+	}
+
+	application.SetStatus(ApplicationStatusStopped)
+
+	return report
+}
+
+// waitForDispatches waits for in-flight dispatches to complete and returns how many are still in flight.
+func (application *Application) waitForDispatches(ctx context.Context) int64 {
+	ticker := time.NewTicker(DispatchDrainPollInterval)
+	defer ticker.Stop()
+
+	for {
+		remaining := application.dispatchesInFlight.Load()
+		if remaining <= 0 {
+			return 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return remaining
+		case <-ticker.C:
+		}
+	}
+}
+
+// closeProducer closes the producer if it has not already been closed. Returns true if it was closed by this call.
+func (application *Application) closeProducer() (bool, error) {
+	if application.producer == nil || !application.producerClosed.CompareAndSwap(false, true) {
+		return false, nil
+	}
+
+	return true, errors.Join(application.producer.Close(), application.deadLetters.Close())
+}
+
+// err returns the errors in the report as a single error.
+func (report *ApplicationShutdownReport) err() error {
+	if len(report.Errors) == 0 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(report.Errors))
+	for _, message := range report.Errors {
+		errs = append(errs, errors.New(message))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,334 @@
+package sandwich
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// SocketProducerType is the producer type of Unix socket producers, which is registered by default.
+const SocketProducerType = "socket"
+
+var (
+	// SocketProducerWriteTimeout is how long writing to a subscriber can take before it is disconnected.
+	SocketProducerWriteTimeout = time.Second * 10
+	// SocketProducerDialTimeout is how long connecting to an existing socket can take before it is treated as stale.
+	SocketProducerDialTimeout = time.Second
+)
+
+// SocketProducerConfiguration is where and how a Unix socket producer streams events.
+type SocketProducerConfiguration struct {
+	// Path is the path of the Unix socket. A stale socket at the path is removed, but a socket that is
+	// accepting connections or any other file is not.
+	Path string `json:"path"`
+	// GroupAccess lets the group of the socket connect. Otherwise, only the owner can connect.
+	GroupAccess bool `json:"group_access"`
+	// BufferSize is the number of events that can be buffered for each subscriber before the overflow policy is applied.
+	BufferSize int32 `json:"buffer_size"`
+	// OverflowPolicy is drop_oldest, drop_newest or disconnect. Defaults to drop_oldest.
+	OverflowPolicy ListenerOverflowPolicy `json:"overflow_policy"`
+
+	// Format is json, protobuf or msgpack. Defaults to json, which is streamed as newline delimited JSON.
+	// Other formats are prefixed with their length, see ReadPayloadFrame.
+	Format PayloadFormat `json:"format"`
+}
+
+func (config SocketProducerConfiguration) validate() error {
+	if config.Path == "" {
+		return fmt.Errorf("path: %w", ErrConfigurationMissingField)
+	}
+
+	if config.BufferSize < 0 {
+		return errors.New("buffer_size must not be negative")
+	}
+
+	if _, err := NewPayloadSerializer(config.Format); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SocketProducer is a producer that streams events to every subscriber connected to a Unix socket.
+// Each subscriber has its own buffer, so a slow subscriber cannot block publishing.
+type SocketProducer struct {
+	logger        *slog.Logger
+	configuration SocketProducerConfiguration
+	serializer    PayloadSerializer
+	listener      net.Listener
+
+	mu          sync.RWMutex
+	subscribers map[*socketSubscriber]struct{}
+	closed      bool
+
+	sequence int64
+	done     sync.WaitGroup
+}
+
+type socketSubscriber struct {
+	conn     net.Conn
+	listener *Listener
+}
+
+// NewSocketProducer returns a producer that listens on the configured Unix socket.
+func NewSocketProducer(logger *slog.Logger, configuration SocketProducerConfiguration) (*SocketProducer, error) {
+	if err := configuration.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(configuration.Path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	info, err := os.Lstat(configuration.Path)
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return nil, fmt.Errorf("failed to stat socket: %w", err)
+	case info.Mode()&fs.ModeSocket == 0:
+		return nil, fmt.Errorf("%w: %s", ErrSocketPathInUse, configuration.Path)
+	default:
+		// A socket that accepts connections belongs to a process that is still running.
+		if conn, err := net.DialTimeout("unix", configuration.Path, SocketProducerDialTimeout); err == nil {
+			conn.Close()
+
+			return nil, fmt.Errorf("%w: %s", ErrSocketPathInUse, configuration.Path)
+		}
+
+		if err := os.Remove(configuration.Path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to remove stale socket: %w", err)
+		}
+	}
+
+	listener, err := net.Listen("unix", configuration.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	mode := fs.FileMode(0o600)
+	if configuration.GroupAccess {
+		mode = 0o660
+	}
+
+	if err := os.Chmod(configuration.Path, mode); err != nil {
+		listener.Close()
+
+		return nil, fmt.Errorf("failed to set socket permissions: %w", err)
+	}
+
+	p := &SocketProducer{
+		logger:        logger.With("socket", configuration.Path),
+		configuration: configuration,
+		listener:      listener,
+
+		subscribers: make(map[*socketSubscriber]struct{}),
+	}
+
+	// The format has already been validated.
+	p.serializer, _ = NewPayloadSerializer(configuration.Format)
+
+	p.done.Add(1)
+
+	go p.accept()
+
+	return p, nil
+}
+
+func (p *SocketProducer) accept() {
+	defer p.done.Done()
+
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				p.logger.Error("Failed to accept subscriber", "error", err)
+			}
+
+			return
+		}
+
+		subscriber := &socketSubscriber{
+			conn:     conn,
+			listener: NewListener(int(p.configuration.BufferSize), p.configuration.OverflowPolicy),
+		}
+
+		p.mu.Lock()
+
+		if p.closed {
+			p.mu.Unlock()
+			conn.Close()
+
+			return
+		}
+
+		p.subscribers[subscriber] = struct{}{}
+		p.done.Add(1)
+		p.mu.Unlock()
+
+		p.logger.Debug("Subscriber connected")
+
+		go p.stream(subscriber)
+	}
+}
+
+// stream writes events to a subscriber until it disconnects, overflows or the producer is closed.
+func (p *SocketProducer) stream(subscriber *socketSubscriber) {
+	defer p.done.Done()
+
+	defer func() {
+		p.mu.Lock()
+		delete(p.subscribers, subscriber)
+		p.mu.Unlock()
+
+		subscriber.conn.Close()
+
+		p.logger.Debug("Subscriber disconnected", "dropped", subscriber.listener.Dropped())
+	}()
+
+	// Subscribers do not send anything, so a read only returns once the connection is closed.
+	disconnected := make(chan struct{})
+
+	go func() {
+		_, _ = subscriber.conn.Read(make([]byte, 1))
+		close(disconnected)
+	}()
+
+	// Closing the connection on overflow interrupts a write that is blocked on the subscriber.
+	go func() {
+		select {
+		case <-disconnected:
+		case <-subscriber.listener.Done():
+			p.logger.Warn("Disconnected subscriber", "error", ErrListenerOverflow)
+			subscriber.conn.Close()
+		}
+	}()
+
+	for {
+		select {
+		case <-disconnected:
+			return
+		case <-subscriber.listener.Done():
+			return
+		case data, ok := <-subscriber.listener.channel:
+			if !ok {
+				return
+			}
+
+			_ = subscriber.conn.SetWriteDeadline(time.Now().Add(SocketProducerWriteTimeout))
+
+			if _, err := subscriber.conn.Write(data.payload); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Publish buffers the event for every subscriber. Events are dropped when there are no subscribers.
+func (p *SocketProducer) Publish(_ context.Context, _ *Shard, payload *ProducedPayload) error {
+	data, err := marshalPayloadFrame(p.serializer, payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return ErrProducerClosed
+	}
+
+	p.sequence++
+
+	message := &listenerData{
+		timestamp: time.Now(),
+		sequence:  p.sequence,
+		payload:   data,
+	}
+
+	for subscriber := range p.subscribers {
+		subscriber.listener.push(message)
+	}
+
+	return nil
+}
+
+// Subscribers returns the number of connected subscribers.
+func (p *SocketProducer) Subscribers() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return len(p.subscribers)
+}
+
+func (p *SocketProducer) Flush(_ context.Context) error {
+	return nil
+}
+
+// Close stops listening and disconnects every subscriber. Buffered events that have not been written are discarded.
+func (p *SocketProducer) Close() error {
+	p.mu.Lock()
+
+	if p.closed {
+		p.mu.Unlock()
+
+		return nil
+	}
+
+	p.closed = true
+
+	for subscriber := range p.subscribers {
+		subscriber.conn.Close()
+	}
+
+	p.mu.Unlock()
+
+	err := p.listener.Close()
+
+	p.done.Wait()
+
+	return err
+}
+
+// SocketProducerProvider is a ProducerProvider that streams the events of each application to a Unix socket
+// named after its identifier.
+type SocketProducerProvider struct {
+	logger        *slog.Logger
+	directory     string
+	configuration SocketProducerConfiguration
+}
+
+// NewSocketProducerProvider returns a ProducerProvider that creates a socket producer listening on
+// <directory>/<application identifier>.sock for each application. The path of the configuration is ignored.
+func NewSocketProducerProvider(logger *slog.Logger, directory string, configuration SocketProducerConfiguration) *SocketProducerProvider {
+	return &SocketProducerProvider{
+		logger:        logger,
+		directory:     directory,
+		configuration: configuration,
+	}
+}
+
+func (p *SocketProducerProvider) GetProducer(_ context.Context, applicationIdentifier, _ string) (Producer, error) {
+	configuration := p.configuration
+	configuration.Path = filepath.Join(p.directory, applicationIdentifier+".sock")
+
+	return NewSocketProducer(p.logger, configuration)
+}
+
+func socketProducerFactory(_ context.Context, application *Application, producerConfiguration ProducerConfiguration) (Producer, error) {
+	var configuration SocketProducerConfiguration
+
+	if err := json.Unmarshal(producerConfiguration.Options, &configuration); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal socket options: %w", err)
+	}
+
+	return NewSocketProducer(application.Logger, configuration)
+}
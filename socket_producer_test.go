@@ -0,0 +1,205 @@
+package sandwich_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+// shortTempDir returns a temporary directory with a path short enough for a Unix socket.
+func shortTempDir(t *testing.T) string {
+	t.Helper()
+
+	directory, err := os.MkdirTemp("", "sandwich")
+	assert.NoError(t, err)
+
+	t.Cleanup(func() { os.RemoveAll(directory) })
+
+	return directory
+}
+
+func TestSocketProducerStreamsToSubscribers(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(shortTempDir(t), "welcomer.sock")
+
+	producer, err := sandwich.NewSocketProducer(slog.Default(), sandwich.SocketProducerConfiguration{Path: path})
+	assert.NoError(t, err)
+
+	var readers []*bufio.Scanner
+
+	for range 2 {
+		conn, err := net.Dial("unix", path)
+		assert.NoError(t, err)
+
+		defer conn.Close()
+
+		readers = append(readers, bufio.NewScanner(conn))
+	}
+
+	assert.Eventually(t, func() bool { return producer.Subscribers() == 2 }, time.Second*5, time.Millisecond*10)
+
+	events := publishSpoolEvents(t, producer, 0, 5)
+
+	for _, reader := range readers {
+		var eventTypes []string
+
+		for range events {
+			assert.True(t, reader.Scan())
+
+			var payload sandwich.ProducedPayload
+
+			assert.NoError(t, json.Unmarshal(reader.Bytes(), &payload))
+
+			eventTypes = append(eventTypes, payload.Type)
+		}
+
+		assert.Equal(t, events, eventTypes)
+	}
+
+	// Subscribers are disconnected once the producer is closed.
+	assert.NoError(t, producer.Close())
+	assert.False(t, readers[0].Scan())
+	assert.ErrorIs(t, producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{}), sandwich.ErrProducerClosed)
+}
+
+func TestSocketProducerDisconnectsSlowSubscribers(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(shortTempDir(t), "welcomer.sock")
+
+	var configuration sandwich.SocketProducerConfiguration
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"path": "`+path+`", "buffer_size": 1, "overflow_policy": "disconnect"}`), &configuration))
+	assert.Equal(t, sandwich.ListenerOverflowDisconnect, configuration.OverflowPolicy)
+
+	producer, err := sandwich.NewSocketProducer(slog.Default(), configuration)
+	assert.NoError(t, err)
+
+	defer producer.Close()
+
+	// The subscriber never reads, so once the socket buffers fill up it overflows.
+	conn, err := net.Dial("unix", path)
+	assert.NoError(t, err)
+
+	defer conn.Close()
+
+	assert.Eventually(t, func() bool { return producer.Subscribers() == 1 }, time.Second*5, time.Millisecond*10)
+
+	assert.Eventually(t, func() bool {
+		publishSpoolEvents(t, producer, 0, 100)
+
+		return producer.Subscribers() == 0
+	}, time.Second*10, time.Millisecond*10)
+}
+
+func TestSocketProducerPath(t *testing.T) {
+	t.Parallel()
+
+	directory := shortTempDir(t)
+
+	// Files that are not sockets are never removed.
+	path := filepath.Join(directory, "welcomer.sock")
+	assert.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+
+	_, err := sandwich.NewSocketProducer(slog.Default(), sandwich.SocketProducerConfiguration{Path: path})
+	assert.ErrorIs(t, err, sandwich.ErrSocketPathInUse)
+	assert.FileExists(t, path)
+
+	// Sockets that another process is still listening on are not replaced.
+	path = filepath.Join(directory, "live.sock")
+
+	live, err := net.Listen("unix", path)
+	assert.NoError(t, err)
+
+	_, err = sandwich.NewSocketProducer(slog.Default(), sandwich.SocketProducerConfiguration{Path: path})
+	assert.ErrorIs(t, err, sandwich.ErrSocketPathInUse)
+
+	conn, err := net.Dial("unix", path)
+	assert.NoError(t, err, "the listening socket should still accept connections")
+
+	if conn != nil {
+		assert.NoError(t, conn.Close())
+	}
+
+	assert.NoError(t, live.Close())
+
+	// Stale sockets left behind by a previous process are replaced.
+	path = filepath.Join(directory, "stale.sock")
+
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	assert.NoError(t, err)
+
+	listener.SetUnlinkOnClose(false)
+	assert.NoError(t, listener.Close())
+
+	producer, err := sandwich.NewSocketProducer(slog.Default(), sandwich.SocketProducerConfiguration{Path: path})
+	assert.NoError(t, err)
+	assert.NoError(t, producer.Close())
+
+	for _, test := range []struct {
+		groupAccess bool
+		mode        os.FileMode
+	}{
+		{groupAccess: false, mode: 0o600},
+		{groupAccess: true, mode: 0o660},
+	} {
+		path := filepath.Join(directory, "group.sock")
+
+		producer, err := sandwich.NewSocketProducer(slog.Default(), sandwich.SocketProducerConfiguration{Path: path, GroupAccess: test.groupAccess})
+		assert.NoError(t, err)
+
+		info, err := os.Stat(path)
+		assert.NoError(t, err)
+		assert.Equal(t, test.mode, info.Mode().Perm())
+
+		assert.NoError(t, producer.Close())
+	}
+}
+
+func TestSocketProducerFormat(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(shortTempDir(t), "welcomer.sock")
+
+	producer, err := sandwich.NewSocketProducer(slog.Default(), sandwich.SocketProducerConfiguration{
+		Path:   path,
+		Format: sandwich.PayloadFormatProtobuf,
+	})
+	assert.NoError(t, err)
+
+	defer producer.Close()
+
+	conn, err := net.Dial("unix", path)
+	assert.NoError(t, err)
+
+	defer conn.Close()
+
+	assert.Eventually(t, func() bool { return producer.Subscribers() == 1 }, time.Second*5, time.Millisecond*10)
+
+	events := publishSpoolEvents(t, producer, 0, 5)
+
+	// Binary formats are prefixed with their length, as they can contain newlines.
+	reader := bufio.NewReader(conn)
+
+	var eventTypes []string
+
+	for range events {
+		var payload sandwich.ProducedPayload
+
+		assert.NoError(t, sandwich.ReadPayloadFrame(reader, sandwich.ProtobufPayloadSerializer, &payload))
+
+		eventTypes = append(eventTypes, payload.Type)
+	}
+
+	assert.Equal(t, events, eventTypes)
+}
@@ -0,0 +1,812 @@
+package sandwich
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SpoolFsyncPolicy is when the spool syncs what it has written to disk.
+type SpoolFsyncPolicy string
+
+const (
+	// SpoolFsyncAlways syncs after every event. Nothing is lost if the machine crashes, but it is slow.
+	SpoolFsyncAlways SpoolFsyncPolicy = "always"
+	// SpoolFsyncInterval syncs every FsyncIntervalMilliseconds.
+	SpoolFsyncInterval SpoolFsyncPolicy = "interval"
+	// SpoolFsyncNever leaves syncing to the operating system.
+	SpoolFsyncNever SpoolFsyncPolicy = "never"
+)
+
+var (
+	// DefaultSpoolSegmentBytes is the size segments are rolled at when SegmentBytes is not set.
+	DefaultSpoolSegmentBytes int64 = 64 * 1024 * 1024
+	// DefaultSpoolFsyncInterval is how often segments are synced when FsyncIntervalMilliseconds is not set.
+	DefaultSpoolFsyncInterval = time.Second
+	// SpoolRetryInterval is how long the forwarder waits before publishing an event again after the producer failed.
+	SpoolRetryInterval = time.Second
+	// SpoolCursorInterval is how often the position of the forwarder is saved. Events forwarded after the
+	// position was last saved are forwarded again after a restart.
+	SpoolCursorInterval = time.Millisecond * 100
+)
+
+const (
+	// spoolRecordHeaderSize is the size of the length, checksum and timestamp before each event.
+	spoolRecordHeaderSize = 16
+	spoolSegmentExtension = ".seg"
+	spoolCursorFile       = "cursor"
+)
+
+// ProducerSpoolConfiguration writes events to segment files on disk before they are published. A forwarder publishes
+// them to the producer in order, so events are kept while the producer is unavailable and across restarts.
+type ProducerSpoolConfiguration struct {
+	// Directory is where segments are written. The spool is disabled when empty.
+	Directory string `json:"directory"`
+	// SegmentBytes is the size a segment is rolled at. Defaults to 64 MiB.
+	SegmentBytes int64 `json:"segment_bytes"`
+	// MaxBytes is the most events that can be waiting to be forwarded, in bytes. Events are rejected once it
+	// is reached. There is no limit when 0.
+	MaxBytes int64 `json:"max_bytes"`
+
+	// Fsync is always, interval or never. Defaults to interval.
+	Fsync                     SpoolFsyncPolicy `json:"fsync"`
+	FsyncIntervalMilliseconds int32            `json:"fsync_interval_milliseconds"`
+
+	// PauseBytes pauses reading events from the gateway when this many bytes are waiting to be forwarded.
+	// Shards that are paused for too long miss heartbeats and resume once reading continues. Disabled when 0.
+	PauseBytes int64 `json:"pause_bytes"`
+	// ResumeBytes resumes reading events once the bytes waiting to be forwarded drops to it. Defaults to half of PauseBytes.
+	ResumeBytes int64 `json:"resume_bytes"`
+}
+
+// Enabled returns true if events are spooled.
+func (config ProducerSpoolConfiguration) Enabled() bool {
+	return config.Directory != ""
+}
+
+func (config ProducerSpoolConfiguration) segmentBytes() int64 {
+	if config.SegmentBytes > 0 {
+		return config.SegmentBytes
+	}
+
+	return DefaultSpoolSegmentBytes
+}
+
+func (config ProducerSpoolConfiguration) fsyncInterval() time.Duration {
+	if config.FsyncIntervalMilliseconds > 0 {
+		return time.Duration(config.FsyncIntervalMilliseconds) * time.Millisecond
+	}
+
+	return DefaultSpoolFsyncInterval
+}
+
+func (config ProducerSpoolConfiguration) resumeBytes() int64 {
+	if config.ResumeBytes > 0 {
+		return config.ResumeBytes
+	}
+
+	return config.PauseBytes / 2
+}
+
+// spoolCursor is the position of the next event to forward.
+type spoolCursor struct {
+	Segment int64 `json:"segment"`
+	Offset  int64 `json:"offset"`
+}
+
+// SpoolProducer is a producer that writes events to a write-ahead spool on disk, which a forwarder publishes
+// to another producer in order. Events are delivered at least once.
+type SpoolProducer struct {
+	logger        *slog.Logger
+	identifier    string
+	producer      Producer
+	configuration ProducerSpoolConfiguration
+
+	// shard returns the shard that forwarded events are published with. When nil, no shard is passed.
+	shard func(shardID int32) *Shard
+	// setPaused pauses or resumes reading events from the gateway. When nil, there is no backpressure.
+	setPaused func(paused bool)
+
+	mu           sync.Mutex
+	writeSegment int64
+	writeFile    *os.File
+	writeSize    int64
+	unsynced     bool
+
+	// lagBytes is the size of the events that have not been forwarded.
+	lagBytes *atomic.Int64
+	paused   *atomic.Bool
+	closed   *atomic.Bool
+
+	notify chan struct{}
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// Only used by the forwarder.
+	readSegment      int64
+	readOffset       int64
+	readFile         *os.File
+	readLimit        int64
+	readLimitSegment int64
+	cursorSavedAt    time.Time
+	cursorDirty      bool
+}
+
+// NewSpoolProducer opens the spool in the configured directory and starts forwarding events that are in it to the
+// producer. Identifier is the application identifier used in metrics.
+func NewSpoolProducer(logger *slog.Logger, identifier string, producer Producer, configuration ProducerSpoolConfiguration) (*SpoolProducer, error) {
+	p := &SpoolProducer{
+		logger:        logger.With("spool", configuration.Directory),
+		identifier:    identifier,
+		producer:      producer,
+		configuration: configuration,
+
+		lagBytes: &atomic.Int64{},
+		paused:   &atomic.Bool{},
+		closed:   &atomic.Bool{},
+
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+
+		readLimitSegment: -1,
+	}
+
+	if err := p.open(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	go p.forward(ctx)
+
+	if configuration.Fsync == "" || configuration.Fsync == SpoolFsyncInterval {
+		go p.syncEvery(ctx, configuration.fsyncInterval())
+	}
+
+	return p, nil
+}
+
+func (p *SpoolProducer) segmentPath(segment int64) string {
+	return filepath.Join(p.configuration.Directory, fmt.Sprintf("%020d%s", segment, spoolSegmentExtension))
+}
+
+// segments returns the segments in the directory, oldest first.
+func (p *SpoolProducer) segments() ([]int64, error) {
+	entries, err := os.ReadDir(p.configuration.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spool directory: %w", err)
+	}
+
+	var segments []int64
+
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), spoolSegmentExtension)
+		if !ok || entry.IsDir() {
+			continue
+		}
+
+		segment, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		segments = append(segments, segment)
+	}
+
+	slices.Sort(segments)
+
+	return segments, nil
+}
+
+// open recovers the position of the forwarder and the events waiting to be forwarded, and starts a new segment.
+func (p *SpoolProducer) open() error {
+	if err := os.MkdirAll(p.configuration.Directory, 0o755); err != nil {
+		return fmt.Errorf("failed to create spool directory: %w", err)
+	}
+
+	segments, err := p.segments()
+	if err != nil {
+		return err
+	}
+
+	cursor, err := p.loadCursor()
+	if err != nil {
+		return err
+	}
+
+	// Segments before the cursor have been forwarded. Segments after the last are started fresh, as
+	// the last segment may end with an event that was not completely written.
+	p.writeSegment = cursor.Segment
+
+	if len(segments) > 0 {
+		p.writeSegment = max(p.writeSegment, segments[len(segments)-1]+1)
+	}
+
+	p.readSegment = p.writeSegment
+
+	for _, segment := range segments {
+		if segment < cursor.Segment {
+			if err := os.Remove(p.segmentPath(segment)); err != nil {
+				return fmt.Errorf("failed to remove forwarded segment: %w", err)
+			}
+
+			continue
+		}
+
+		info, err := os.Stat(p.segmentPath(segment))
+		if err != nil {
+			return fmt.Errorf("failed to stat segment: %w", err)
+		}
+
+		size := info.Size()
+
+		if p.readSegment == p.writeSegment {
+			p.readSegment = segment
+
+			if segment == cursor.Segment {
+				p.readOffset = min(cursor.Offset, size)
+				size -= p.readOffset
+			}
+		}
+
+		p.lagBytes.Add(size)
+	}
+
+	file, err := os.OpenFile(p.segmentPath(p.writeSegment), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open segment: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+
+		return fmt.Errorf("failed to stat segment: %w", err)
+	}
+
+	p.writeFile = file
+	p.writeSize = info.Size()
+
+	UpdateSpoolLag(p.identifier, p.lagBytes.Load())
+
+	if p.lagBytes.Load() > 0 {
+		p.logger.Info("Recovered spooled events", "bytes", p.lagBytes.Load())
+	}
+
+	return nil
+}
+
+func (p *SpoolProducer) loadCursor() (spoolCursor, error) {
+	var cursor spoolCursor
+
+	data, err := os.ReadFile(filepath.Join(p.configuration.Directory, spoolCursorFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return cursor, nil
+		}
+
+		return cursor, fmt.Errorf("failed to read spool cursor: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return cursor, fmt.Errorf("failed to unmarshal spool cursor: %w", err)
+	}
+
+	return cursor, nil
+}
+
+// saveCursor saves the position of the forwarder. The cursor is replaced rather than written in place,
+// so it is never partially written.
+func (p *SpoolProducer) saveCursor() error {
+	data, err := json.Marshal(spoolCursor{Segment: p.readSegment, Offset: p.readOffset})
+	if err != nil {
+		return fmt.Errorf("failed to marshal spool cursor: %w", err)
+	}
+
+	path := filepath.Join(p.configuration.Directory, spoolCursorFile)
+
+	file, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open spool cursor: %w", err)
+	}
+
+	_, err = file.Write(data)
+
+	if err == nil && p.configuration.Fsync != SpoolFsyncNever {
+		err = file.Sync()
+	}
+
+	if err = errors.Join(err, file.Close()); err != nil {
+		return fmt.Errorf("failed to write spool cursor: %w", err)
+	}
+
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return fmt.Errorf("failed to replace spool cursor: %w", err)
+	}
+
+	p.cursorSavedAt = time.Now()
+	p.cursorDirty = false
+
+	return nil
+}
+
+// Publish writes the event to the spool. It is published to the producer by the forwarder.
+func (p *SpoolProducer) Publish(_ context.Context, _ *Shard, payload *ProducedPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	record := make([]byte, spoolRecordHeaderSize+len(data))
+	binary.LittleEndian.PutUint32(record[0:4], uint32(len(data)))
+	binary.LittleEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(data))
+	binary.LittleEndian.PutUint64(record[8:16], uint64(time.Now().UnixNano()))
+	copy(record[spoolRecordHeaderSize:], data)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.writeFile == nil {
+		return os.ErrClosed
+	}
+
+	size := int64(len(record))
+
+	if p.configuration.MaxBytes > 0 && p.lagBytes.Load()+size > p.configuration.MaxBytes {
+		RecordSpoolRejected(p.identifier)
+
+		return ErrSpoolFull
+	}
+
+	if _, err := p.writeFile.Write(record); err != nil {
+		return fmt.Errorf("failed to write to segment: %w", err)
+	}
+
+	p.writeSize += size
+	p.unsynced = true
+
+	if p.configuration.Fsync == SpoolFsyncAlways {
+		if err := p.sync(); err != nil {
+			return err
+		}
+	}
+
+	lag := p.lagBytes.Add(size)
+	UpdateSpoolLag(p.identifier, lag)
+
+	if p.writeSize >= p.configuration.segmentBytes() {
+		if err := p.roll(); err != nil {
+			return err
+		}
+	}
+
+	if p.configuration.PauseBytes > 0 && lag >= p.configuration.PauseBytes {
+		p.setBackpressure(true)
+	}
+
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// sync syncs the write segment. Must be called with mu held.
+func (p *SpoolProducer) sync() error {
+	if !p.unsynced || p.writeFile == nil {
+		return nil
+	}
+
+	if err := p.writeFile.Sync(); err != nil {
+		return fmt.Errorf("failed to sync segment: %w", err)
+	}
+
+	p.unsynced = false
+
+	return nil
+}
+
+// roll closes the write segment and starts the next one. Must be called with mu held.
+func (p *SpoolProducer) roll() error {
+	if err := p.sync(); err != nil {
+		return err
+	}
+
+	if err := p.writeFile.Close(); err != nil {
+		return fmt.Errorf("failed to close segment: %w", err)
+	}
+
+	file, err := os.OpenFile(p.segmentPath(p.writeSegment+1), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		p.writeFile = nil
+
+		return fmt.Errorf("failed to open segment: %w", err)
+	}
+
+	p.writeSegment++
+	p.writeFile = file
+	p.writeSize = 0
+
+	return nil
+}
+
+func (p *SpoolProducer) syncEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.mu.Lock()
+			err := p.sync()
+			p.mu.Unlock()
+
+			if err != nil {
+				p.logger.Error("Failed to sync spool", "error", err)
+			}
+		}
+	}
+}
+
+// setBackpressure pauses or resumes reading events from the gateway.
+func (p *SpoolProducer) setBackpressure(paused bool) {
+	if p.paused.CompareAndSwap(!paused, paused) {
+		if paused {
+			p.logger.Warn("Pausing shard reads as the spool is behind", "bytes", p.lagBytes.Load())
+		} else {
+			p.logger.Info("Resuming shard reads", "bytes", p.lagBytes.Load())
+		}
+
+		UpdateSpoolPaused(p.identifier, paused)
+
+		if p.setPaused != nil {
+			p.setPaused(paused)
+		}
+	}
+}
+
+// errSpoolEmpty is returned by next when every event has been forwarded.
+var errSpoolEmpty = errors.New("spool is empty")
+
+// forward publishes spooled events to the producer until the context is cancelled.
+func (p *SpoolProducer) forward(ctx context.Context) {
+	defer close(p.done)
+
+	for {
+		record, timestamp, size, err := p.next()
+		if err != nil {
+			if !errors.Is(err, errSpoolEmpty) {
+				p.logger.Error("Failed to read spool", "error", err)
+			}
+
+			UpdateSpoolEventAge(p.identifier, 0)
+			p.persistCursor(false)
+
+			// Wake up to save the cursor if it could not be saved yet.
+			wait := SpoolRetryInterval
+			if p.cursorDirty {
+				wait = SpoolCursorInterval
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-p.notify:
+			case <-time.After(wait):
+			}
+
+			continue
+		}
+
+		if !p.forwardRecord(ctx, record, timestamp) {
+			return
+		}
+
+		p.readOffset += size
+		p.cursorDirty = true
+
+		lag := p.lagBytes.Add(-size)
+		UpdateSpoolLag(p.identifier, lag)
+		RecordSpoolForwarded(p.identifier)
+
+		if p.paused.Load() && lag <= p.configuration.resumeBytes() {
+			p.setBackpressure(false)
+		}
+
+		p.persistCursor(false)
+	}
+}
+
+// persistCursor saves the cursor if it has changed and has not been saved recently, or always when force is true.
+func (p *SpoolProducer) persistCursor(force bool) {
+	if !force && (!p.cursorDirty || time.Since(p.cursorSavedAt) < SpoolCursorInterval) {
+		return
+	}
+
+	if err := p.saveCursor(); err != nil {
+		p.logger.Error("Failed to save spool cursor", "error", err)
+	}
+}
+
+// forwardRecord publishes an event to the producer, retrying until it succeeds. Returns false if the context was
+// cancelled first. Events that cannot be read are skipped.
+func (p *SpoolProducer) forwardRecord(ctx context.Context, record []byte, timestamp time.Time) bool {
+	var payload ProducedPayload
+
+	if err := json.Unmarshal(record, &payload); err != nil {
+		p.logger.Error("Skipping spooled event that cannot be read", "error", err)
+
+		return true
+	}
+
+	var shard *Shard
+
+	if p.shard != nil {
+		shard = p.shard(payload.Metadata.Shard[1])
+	}
+
+	for {
+		UpdateSpoolEventAge(p.identifier, time.Since(timestamp))
+
+		err := p.producer.Publish(ctx, shard, &payload)
+		if err == nil {
+			return true
+		}
+
+		p.logger.Warn("Failed to forward spooled event", "type", payload.Type, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(SpoolRetryInterval):
+		}
+	}
+}
+
+// next reads the next event to forward. Segments that have been forwarded are removed.
+func (p *SpoolProducer) next() (record []byte, timestamp time.Time, size int64, err error) {
+	for {
+		p.mu.Lock()
+		writeSegment, writeSize := p.writeSegment, p.writeSize
+		p.mu.Unlock()
+
+		limit := writeSize
+
+		if p.readSegment != writeSegment {
+			if limit, err = p.segmentLimit(); err != nil {
+				return nil, time.Time{}, 0, err
+			}
+		}
+
+		if p.readOffset+spoolRecordHeaderSize > limit {
+			if p.readSegment == writeSegment {
+				return nil, time.Time{}, 0, errSpoolEmpty
+			}
+
+			if err := p.nextSegment(limit, writeSegment); err != nil {
+				return nil, time.Time{}, 0, err
+			}
+
+			continue
+		}
+
+		if p.readFile == nil {
+			if p.readFile, err = os.Open(p.segmentPath(p.readSegment)); err != nil {
+				return nil, time.Time{}, 0, fmt.Errorf("failed to open segment: %w", err)
+			}
+		}
+
+		header := make([]byte, spoolRecordHeaderSize)
+		if _, err := p.readFile.ReadAt(header, p.readOffset); err != nil {
+			return nil, time.Time{}, 0, fmt.Errorf("failed to read segment: %w", err)
+		}
+
+		length := int64(binary.LittleEndian.Uint32(header[0:4]))
+		checksum := binary.LittleEndian.Uint32(header[4:8])
+		timestamp = time.Unix(0, int64(binary.LittleEndian.Uint64(header[8:16])))
+		size = spoolRecordHeaderSize + length
+
+		if p.readOffset+size <= limit {
+			record = make([]byte, length)
+
+			_, err = p.readFile.ReadAt(record, p.readOffset+spoolRecordHeaderSize)
+			if err != nil && !errors.Is(err, io.EOF) {
+				return nil, time.Time{}, 0, fmt.Errorf("failed to read segment: %w", err)
+			}
+
+			if err == nil && crc32.ChecksumIEEE(record) == checksum {
+				return record, timestamp, size, nil
+			}
+		}
+
+		// The rest of the segment cannot be trusted, such as an event that was partially written before a crash.
+		p.logger.Error("Skipping corrupt spool segment", "segment", p.readSegment, "offset", p.readOffset, "bytes", limit-p.readOffset)
+
+		p.lagBytes.Add(-(limit - p.readOffset))
+		p.readOffset = limit
+		p.cursorDirty = true
+	}
+}
+
+// segmentLimit returns the size of the read segment, once it is no longer being written to.
+func (p *SpoolProducer) segmentLimit() (int64, error) {
+	if p.readLimitSegment != p.readSegment {
+		info, err := os.Stat(p.segmentPath(p.readSegment))
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				return 0, fmt.Errorf("failed to stat segment: %w", err)
+			}
+
+			info = nil
+		}
+
+		p.readLimit = 0
+		if info != nil {
+			p.readLimit = info.Size()
+		}
+
+		p.readLimitSegment = p.readSegment
+	}
+
+	return p.readLimit, nil
+}
+
+// nextSegment removes the read segment once it has been forwarded and moves to the next one.
+func (p *SpoolProducer) nextSegment(limit, writeSegment int64) error {
+	if remaining := limit - p.readOffset; remaining > 0 {
+		p.logger.Error("Skipping incomplete event at the end of spool segment", "segment", p.readSegment, "bytes", remaining)
+		p.lagBytes.Add(-remaining)
+	}
+
+	if p.readFile != nil {
+		p.readFile.Close()
+		p.readFile = nil
+	}
+
+	if err := os.Remove(p.segmentPath(p.readSegment)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove forwarded segment: %w", err)
+	}
+
+	p.readSegment = min(p.readSegment+1, writeSegment)
+	p.readOffset = 0
+
+	p.persistCursor(true)
+
+	return nil
+}
+
+// Lag returns the size of the events that have not been forwarded, in bytes.
+func (p *SpoolProducer) Lag() int64 {
+	return p.lagBytes.Load()
+}
+
+// Flush waits for spooled events to be forwarded, then flushes the producer. Events that are not forwarded before
+// the context ends are kept on disk and forwarded when the spool is next opened.
+func (p *SpoolProducer) Flush(ctx context.Context) error {
+	ticker := time.NewTicker(DispatchDrainPollInterval)
+	defer ticker.Stop()
+
+	for p.lagBytes.Load() > 0 && ctx.Err() == nil {
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+	}
+
+	p.mu.Lock()
+	err := p.sync()
+	p.mu.Unlock()
+
+	if flusher, ok := p.producer.(Flusher); ok && ctx.Err() == nil {
+		err = errors.Join(err, flusher.Flush(ctx))
+	}
+
+	return err
+}
+
+// Close stops the forwarder, closes the spool and closes the producer.
+func (p *SpoolProducer) Close() error {
+	if !p.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	p.cancel()
+	<-p.done
+
+	err := p.saveCursor()
+
+	if p.readFile != nil {
+		p.readFile.Close()
+		p.readFile = nil
+	}
+
+	p.mu.Lock()
+
+	if p.writeFile != nil {
+		err = errors.Join(err, p.sync(), p.writeFile.Close())
+		p.writeFile = nil
+	}
+
+	p.mu.Unlock()
+
+	p.setBackpressure(false)
+
+	return errors.Join(err, p.producer.Close())
+}
+
+// spoolProducer wraps the producer of an application in a SpoolProducer, if the spool is enabled.
+// The spool closes the producer if it fails to open.
+func (application *Application) spoolProducer(configuration *ApplicationConfiguration, producer Producer) (Producer, error) {
+	if !configuration.ProducerSpool.Enabled() {
+		return producer, nil
+	}
+
+	spoolProducer, err := NewSpoolProducer(application.Logger, configuration.ApplicationIdentifier, producer, configuration.ProducerSpool)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to open spool: %w", err), producer.Close())
+	}
+
+	spoolProducer.shard = func(shardID int32) *Shard {
+		shard, _ := application.Shards.Load(shardID)
+
+		return shard
+	}
+	spoolProducer.setPaused = application.setReadsPaused
+
+	return spoolProducer, nil
+}
+
+// setReadsPaused pauses or resumes reading events from the gateway for every shard of the application.
+func (application *Application) setReadsPaused(paused bool) {
+	if paused {
+		resumed := make(chan struct{})
+		application.readsResumed.CompareAndSwap(nil, &resumed)
+
+		return
+	}
+
+	if resumed := application.readsResumed.Swap(nil); resumed != nil {
+		close(*resumed)
+	}
+}
+
+// readsPaused returns true if reading events from the gateway is paused for the application.
+func (application *Application) readsPaused() bool {
+	return application.readsResumed.Load() != nil
+}
+
+// waitForReads blocks while shard reads are paused. Returns ErrShardStopping if the shard is stopped while waiting.
+func (shard *Shard) waitForReads(ctx context.Context) error {
+	resumed := shard.Application.readsResumed.Load()
+	if resumed == nil {
+		return nil
+	}
+
+	shard.Logger.Debug("Waiting for shard reads to resume")
+
+	select {
+	case <-*resumed:
+		return nil
+	case <-shard.stop:
+		return ErrShardStopping
+	case <-ctx.Done():
+		return nil
+	}
+}
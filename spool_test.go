@@ -0,0 +1,215 @@
+package sandwich_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/WelcomerTeam/Discord/discord"
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+func (producer *recordingProducer) published() []string {
+	producer.mu.Lock()
+	defer producer.mu.Unlock()
+
+	return append([]string(nil), producer.events...)
+}
+
+func publishSpoolEvents(t *testing.T, producer sandwich.Producer, from, to int) []string {
+	t.Helper()
+
+	var events []string
+
+	for index := from; index < to; index++ {
+		eventType := "EVENT_" + strconv.Itoa(index)
+		events = append(events, eventType)
+
+		assert.NoError(t, producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{
+			GatewayPayload: discord.GatewayPayload{Type: eventType},
+		}))
+	}
+
+	return events
+}
+
+func TestSpoolProducerSurvivesRestart(t *testing.T) {
+	t.Parallel()
+
+	configuration := sandwich.ProducerSpoolConfiguration{
+		Directory:    t.TempDir(),
+		SegmentBytes: 256,
+	}
+
+	// The producer is unavailable, so every event stays in the spool.
+	unavailable := &recordingProducer{err: errors.New("unavailable")}
+
+	spool, err := sandwich.NewSpoolProducer(slog.Default(), "welcomer", unavailable, configuration)
+	assert.NoError(t, err)
+
+	events := publishSpoolEvents(t, spool, 0, 20)
+	assert.Positive(t, spool.Lag())
+	assert.NoError(t, spool.Close())
+
+	segments, err := filepath.Glob(filepath.Join(configuration.Directory, "*.seg"))
+	assert.NoError(t, err)
+	assert.Greater(t, len(segments), 1)
+
+	// Once the producer is available again, the events are forwarded in order.
+	available := &recordingProducer{}
+
+	spool, err = sandwich.NewSpoolProducer(slog.Default(), "welcomer", available, configuration)
+	assert.NoError(t, err)
+
+	events = append(events, publishSpoolEvents(t, spool, 20, 25)...)
+
+	assert.Eventually(t, func() bool { return spool.Lag() == 0 }, time.Second*5, time.Millisecond*10)
+	assert.Equal(t, events, available.published())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	assert.NoError(t, spool.Flush(ctx))
+	assert.NoError(t, spool.Close())
+
+	// Forwarded segments are removed, leaving only the segment that was being written to.
+	segments, err = filepath.Glob(filepath.Join(configuration.Directory, "*.seg"))
+	assert.NoError(t, err)
+	assert.Len(t, segments, 1)
+
+	// Nothing is forwarded again once the spool is reopened.
+	reopened := &recordingProducer{}
+
+	spool, err = sandwich.NewSpoolProducer(slog.Default(), "welcomer", reopened, configuration)
+	assert.NoError(t, err)
+	assert.Zero(t, spool.Lag())
+	assert.NoError(t, spool.Close())
+	assert.Empty(t, reopened.published())
+}
+
+func TestSpoolProducerSkipsCorruptEvents(t *testing.T) {
+	t.Parallel()
+
+	configuration := sandwich.ProducerSpoolConfiguration{
+		Directory: t.TempDir(),
+	}
+
+	spool, err := sandwich.NewSpoolProducer(slog.Default(), "welcomer", &recordingProducer{err: errors.New("unavailable")}, configuration)
+	assert.NoError(t, err)
+
+	events := publishSpoolEvents(t, spool, 0, 3)
+	assert.NoError(t, spool.Close())
+
+	// Simulate a crash part way through writing an event.
+	segments, err := filepath.Glob(filepath.Join(configuration.Directory, "*.seg"))
+	assert.NoError(t, err)
+
+	file, err := os.OpenFile(segments[0], os.O_APPEND|os.O_WRONLY, 0o600)
+	assert.NoError(t, err)
+
+	_, err = file.Write([]byte{0xff, 0x00, 0x00, 0x00, 0x01})
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	available := &recordingProducer{}
+
+	spool, err = sandwich.NewSpoolProducer(slog.Default(), "welcomer", available, configuration)
+	assert.NoError(t, err)
+
+	defer spool.Close()
+
+	assert.Eventually(t, func() bool { return spool.Lag() == 0 }, time.Second*5, time.Millisecond*10)
+	assert.Equal(t, events, available.published())
+}
+
+func TestSpoolProducerMaxBytes(t *testing.T) {
+	t.Parallel()
+
+	spool, err := sandwich.NewSpoolProducer(slog.Default(), "welcomer", &recordingProducer{err: errors.New("unavailable")}, sandwich.ProducerSpoolConfiguration{
+		Directory: t.TempDir(),
+		MaxBytes:  200,
+	})
+	assert.NoError(t, err)
+
+	defer spool.Close()
+
+	var publishErr error
+
+	for index := range 10 {
+		publishErr = spool.Publish(context.Background(), nil, &sandwich.ProducedPayload{
+			GatewayPayload: discord.GatewayPayload{Type: "EVENT_" + strconv.Itoa(index)},
+		})
+		if publishErr != nil {
+			break
+		}
+	}
+
+	assert.ErrorIs(t, publishErr, sandwich.ErrSpoolFull)
+	assert.LessOrEqual(t, spool.Lag(), int64(200))
+}
+
+// staticProducerProvider returns the same producer for every application.
+type staticProducerProvider struct {
+	producer sandwich.Producer
+}
+
+func (provider staticProducerProvider) GetProducer(context.Context, string, string) (sandwich.Producer, error) {
+	return provider.producer, nil
+}
+
+func TestReplayDeadLettersWithSpool(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"url":"wss://gateway.discord.gg","shards":1,"session_start_limit":{"total":1000,"remaining":1000,"reset_after":1000,"max_concurrency":1}}`))
+	}))
+	defer server.Close()
+
+	target, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	deadLetterPath := filepath.Join(t.TempDir(), "dead_letters.ndjson")
+
+	deadLetters, err := sandwich.OpenDeadLetterSpool("welcomer", deadLetterPath, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, deadLetters.Append(&sandwich.DeadLetter{
+		Producer: sandwich.DefaultProducerName,
+		Payload:  sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: "MESSAGE_CREATE"}},
+	}))
+	assert.NoError(t, deadLetters.Close())
+
+	available := &recordingProducer{}
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, &http.Client{Transport: redirectTransport{target: target}}, nil, nil, staticProducerProvider{producer: available}, nil, nil)
+	application := sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{
+		ApplicationIdentifier: "welcomer",
+		BotToken:              "token",
+		ProducerRetry: sandwich.ProducerRetryConfiguration{
+			MaxAttempts:    3,
+			DeadLetterPath: deadLetterPath,
+		},
+		ProducerSpool: sandwich.ProducerSpoolConfiguration{
+			Directory: t.TempDir(),
+		},
+	})
+
+	assert.NoError(t, application.Initialize(context.Background()))
+
+	defer application.Stop(context.Background())
+
+	// Dead letters are replayed with the retrying producer behind the spool.
+	replayed, failed, err := application.ReplayDeadLetters(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), replayed)
+	assert.Equal(t, int64(0), failed)
+	assert.Equal(t, []string{"MESSAGE_CREATE"}, available.published())
+}
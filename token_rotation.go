@@ -0,0 +1,224 @@
+package sandwich
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+// TokenRotationShardTimeout is how long a shard has to become ready after re-identifying with a new token.
+var TokenRotationShardTimeout = time.Minute * 2
+
+// RotateApplicationToken rotates the bot token of an application without stopping it.
+// The new token is validated and must belong to the same user before it is used. If saveConfig
+// is true, the configuration is saved with the new token, which must then be a secret reference.
+func (sandwich *Sandwich) RotateApplicationToken(ctx context.Context, applicationIdentifier, botToken string, saveConfig bool) error {
+	application, ok := sandwich.Applications.Load(applicationIdentifier)
+	if !ok {
+		return ErrApplicationNotFound
+	}
+
+	// Raw tokens are never written to the configuration file.
+	if saveConfig && !IsSecretReference(botToken) {
+		return fmt.Errorf("bot token: %w", ErrSecretNotReference)
+	}
+
+	if err := application.RotateToken(ctx, botToken); err != nil {
+		return err
+	}
+
+	// Replace the application in the daemon configuration so the new token is saved.
+	configuration := *sandwich.Config.Load()
+	configuration.Applications = slices.Clone(configuration.Applications)
+
+	for index, applicationConfig := range configuration.Applications {
+		if applicationConfig.ApplicationIdentifier == applicationIdentifier {
+			configuration.Applications[index] = application.Configuration.Load()
+		}
+	}
+
+	sandwich.Config.Store(&configuration)
+
+	if saveConfig {
+		err := sandwich.configProvider.SaveConfig(WithConfigSaveReason(ctx, "RotateApplicationToken"), &configuration)
+		if err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RotateToken validates a new bot token, or a reference to one, and switches the application to it.
+// Running shards re-identify with the new token in windows of max_concurrency consecutive shards,
+// one shard per rate limit key, so only max_concurrency shards are reconnecting at once.
+func (application *Application) RotateToken(ctx context.Context, botToken string) error {
+	application.Logger.Info("Rotating bot token")
+
+	newToken, err := application.Sandwich.secretProvider.ResolveSecret(ctx, botToken)
+	if err != nil {
+		return fmt.Errorf("failed to resolve bot token: %w", err)
+	}
+
+	gatewayBotResponse, err := application.fetchGatewayBot(ctx, newToken)
+	if err != nil {
+		return fmt.Errorf("failed to validate bot token: %w", err)
+	}
+
+	user, err := application.fetchCurrentUser(ctx, newToken)
+	if err != nil {
+		return fmt.Errorf("failed to validate bot token: %w", err)
+	}
+
+	oldToken := application.BotToken()
+
+	// Until the application has identified, the bot is looked up with the current token instead.
+	currentUser := application.User.Load()
+	if currentUser == nil {
+		currentToken := oldToken
+
+		// The token is only resolved once the application is initialized.
+		if currentToken == "" {
+			currentToken, err = application.Sandwich.secretProvider.ResolveSecret(ctx, application.Configuration.Load().BotToken)
+			if err != nil {
+				return fmt.Errorf("failed to resolve current bot token: %w", err)
+			}
+		}
+
+		currentUser, err = application.fetchCurrentUser(ctx, currentToken)
+		if err != nil {
+			return fmt.Errorf("failed to fetch the current bot, so the new token cannot be checked against it: %w", err)
+		}
+	}
+
+	if currentUser.ID != user.ID {
+		return fmt.Errorf("%w: expected %d, got %d", ErrApplicationTokenMismatch, currentUser.ID, user.ID)
+	}
+
+	// Move the identify state to the new token before any shard identifies with it.
+	if rotator, ok := application.Sandwich.identifyProvider.(IdentifyTokenRotator); ok {
+		if err := rotator.RotateToken(ctx, oldToken, newToken); err != nil {
+			return fmt.Errorf("failed to rotate identify token: %w", err)
+		}
+	}
+
+	configuration := *application.Configuration.Load()
+	configuration.BotToken = botToken
+
+	application.storeConfiguration(&configuration)
+	application.botToken.Store(&newToken)
+
+	application.storeGateway(gatewayBotResponse)
+
+	if !application.IsRunning() {
+		application.Logger.Info("Rotated bot token")
+
+		return nil
+	}
+
+	if err := application.reidentifyShards(ctx, gatewayBotResponse.SessionStartLimit.MaxConcurrency); err != nil {
+		return err
+	}
+
+	application.Logger.Info("Rotated bot token and re-identified shards")
+
+	return nil
+}
+
+// reidentifyShards reconnects every shard with a new session, one window of max_concurrency shards at a time.
+func (application *Application) reidentifyShards(ctx context.Context, maxConcurrency int32) error {
+	shardIDs := make([]int32, 0, application.Shards.Count())
+
+	application.Shards.Range(func(shardID int32, _ *Shard) bool {
+		shardIDs = append(shardIDs, shardID)
+
+		return true
+	})
+
+	for _, window := range identifyWindows(shardIDs, maxConcurrency) {
+		shards := make([]*Shard, 0, len(window))
+
+		for _, shardID := range window {
+			if shard, ok := application.Shards.Load(shardID); ok {
+				shards = append(shards, shard)
+			}
+		}
+
+		application.Logger.Debug("Re-identifying shards", "first_shard_id", window[0], "shards", len(shards))
+
+		for _, shard := range shards {
+			shard.invalidateSession()
+		}
+
+		for _, shard := range shards {
+			if err := shard.waitForReadyContext(ctx, TokenRotationShardTimeout); err != nil {
+				return fmt.Errorf("shard %d failed to re-identify: %w", shard.ShardID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// identifyWindows groups shards by shardID / maxConcurrency. Each window holds at most maxConcurrency
+// shards with different rate limit keys (shardID % maxConcurrency), so all of them can identify at once.
+func identifyWindows(shardIDs []int32, maxConcurrency int32) [][]int32 {
+	maxConcurrency = max(maxConcurrency, 1)
+
+	shardIDs = slices.Clone(shardIDs)
+	slices.Sort(shardIDs)
+
+	var windows [][]int32
+
+	for index, shardID := range shardIDs {
+		if index == 0 || shardID/maxConcurrency != shardIDs[index-1]/maxConcurrency {
+			windows = append(windows, nil)
+		}
+
+		windows[len(windows)-1] = append(windows[len(windows)-1], shardID)
+	}
+
+	return windows
+}
+
+// invalidateSession clears the session and closes the websocket, so the shard reconnects
+// and identifies instead of resuming.
+func (shard *Shard) invalidateSession() {
+	// Empties the ready channel, so only the next ready is waited for.
+	select {
+	case <-shard.ready:
+	default:
+	}
+
+	emptySessionID := ""
+	emptyResumeGatewayURL := ""
+
+	shard.sessionID.Store(&emptySessionID)
+	shard.resumeGatewayURL.Store(&emptyResumeGatewayURL)
+	shard.sequence.Store(0)
+
+	// Listen will see the connection close and reconnect.
+	_ = shard.closeWS(context.Background(), websocket.StatusNormalClosure)
+}
+
+// waitForReadyContext waits for the shard to be ready, returning early if the context is done or the timeout passes.
+func (shard *Shard) waitForReadyContext(ctx context.Context, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-shard.ready:
+		shard.SetStatus(ShardStatusReady)
+
+		return nil
+	case err := <-shard.error:
+		return err
+	case <-timer.C:
+		return fmt.Errorf("shard was not ready after %s", timeout)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
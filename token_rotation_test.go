@@ -0,0 +1,104 @@
+package sandwich_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotateTokenBeforeIdentify(t *testing.T) {
+	t.Parallel()
+
+	// Each token belongs to the bot with the same ID as the number in the token.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/gateway/bot") {
+			_, _ = w.Write([]byte(`{"url":"wss://gateway.discord.gg","shards":1,"session_start_limit":{"total":1000,"remaining":1000,"reset_after":1000,"max_concurrency":1}}`))
+
+			return
+		}
+
+		botID := strings.TrimSuffix(strings.TrimPrefix(r.Header.Get("Authorization"), "Bot token-"), "-new")
+
+		_, _ = w.Write([]byte(`{"id":"` + botID + `","username":"bot"}`))
+	}))
+	defer server.Close()
+
+	target, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, &http.Client{Transport: redirectTransport{target: target}}, nil, nil, nil, nil, nil)
+	application := sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{
+		ApplicationIdentifier: "test",
+		BotToken:              "token-1",
+	})
+
+	// The application has not identified, so the bot is looked up with the current token.
+	err = application.RotateToken(context.Background(), "token-2")
+	assert.ErrorIs(t, err, sandwich.ErrApplicationTokenMismatch)
+	assert.Equal(t, "token-1", application.Configuration.Load().BotToken)
+
+	assert.NoError(t, application.RotateToken(context.Background(), "token-1-new"))
+	assert.Equal(t, "token-1-new", application.BotToken())
+}
+
+func TestRotateApplicationTokenSavesReferencesOnly(t *testing.T) {
+	t.Parallel()
+
+	sandwichInstance := sandwich.NewSandwich(slog.Default(), nil, nil, nil, nil, nil, nil, nil)
+	application := sandwich.NewApplication(sandwichInstance, &sandwich.ApplicationConfiguration{
+		ApplicationIdentifier: "welcomer",
+		BotToken:              "env:DISCORD_TOKEN",
+	})
+	sandwichInstance.Applications.Store("welcomer", application)
+
+	// The raw token is rejected before it is used, so the configuration keeps the reference.
+	err := sandwichInstance.RotateApplicationToken(context.Background(), "welcomer", "token-1", true)
+	assert.ErrorIs(t, err, sandwich.ErrSecretNotReference)
+	assert.Equal(t, "env:DISCORD_TOKEN", application.Configuration.Load().BotToken)
+}
+
+func TestIdentifyWindows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		shardIDs       []int32
+		maxConcurrency int32
+		windows        [][]int32
+	}{
+		{"no concurrency", []int32{2, 0, 1}, 1, [][]int32{{0}, {1}, {2}}},
+		{"unknown concurrency", []int32{1, 0}, 0, [][]int32{{0}, {1}}},
+		{"consecutive", []int32{5, 4, 3, 2, 1, 0, 6}, 4, [][]int32{{0, 1, 2, 3}, {4, 5, 6}}},
+		{"shard subset", []int32{2, 3, 4, 5, 9}, 4, [][]int32{{2, 3}, {4, 5}, {9}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			windows := sandwich.IdentifyWindows(test.shardIDs, test.maxConcurrency)
+			assert.Equal(t, test.windows, windows)
+
+			// No more than max_concurrency shards reconnect at once, each with a different rate limit key.
+			maxConcurrency := max(test.maxConcurrency, 1)
+
+			for _, window := range windows {
+				assert.LessOrEqual(t, int32(len(window)), maxConcurrency)
+
+				keys := make(map[int32]bool)
+
+				for _, shardID := range window {
+					assert.False(t, keys[shardID%maxConcurrency], "shard %d shares a rate limit key", shardID)
+					keys[shardID%maxConcurrency] = true
+				}
+			}
+		})
+	}
+}
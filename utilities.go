@@ -45,7 +45,7 @@ func ReturnRangeInt32(nodeCount, nodeID int32, rangeString string, max int32) (r
 		} else {
 			if low, err := strconv.Atoi(ranges[0]); err == nil {
 				if hi, err := strconv.Atoi(ranges[len(ranges)-1]); err == nil {
-					for i := int32(low); i < int32(hi+1); i++ {
+					for i := int32(low); i < int32(hi+1) && i < max; i++ {
 						if 0 <= i && i < max {
 							result = append(result, i)
 						}
@@ -70,6 +70,49 @@ func ReturnRangeInt32(nodeCount, nodeID int32, rangeString string, max int32) (r
 	return result
 }
 
+// MaxShardIDs is the most shard IDs ParseShardIDs will expand, so a range such as 0-2147483647 is rejected
+// rather than allocated.
+var MaxShardIDs = 1 << 16
+
+// ParseShardIDs strictly parses a string like 0-4,6-7 to [0,1,2,3,4,6,7].
+// Unlike ReturnRangeInt32, malformed input returns an error instead of being ignored.
+func ParseShardIDs(rangeString string) ([]int32, error) {
+	result := make([]int32, 0)
+
+	for split := range strings.SplitSeq(rangeString, ",") {
+		split = strings.TrimSpace(split)
+
+		lowString, highString, isRange := strings.Cut(split, "-")
+		if !isRange {
+			highString = lowString
+		}
+
+		low, err := strconv.ParseInt(strings.TrimSpace(lowString), 10, 32)
+		if err != nil || low < 0 {
+			return nil, fmt.Errorf("%w: %q is not a shard id or range", ErrApplicationInvalidShardIDs, split)
+		}
+
+		high, err := strconv.ParseInt(strings.TrimSpace(highString), 10, 32)
+		if err != nil || high < 0 {
+			return nil, fmt.Errorf("%w: %q is not a shard id or range", ErrApplicationInvalidShardIDs, split)
+		}
+
+		if low > high {
+			return nil, fmt.Errorf("%w: range %q is reversed", ErrApplicationInvalidShardIDs, split)
+		}
+
+		if int64(len(result))+high-low+1 > int64(MaxShardIDs) {
+			return nil, fmt.Errorf("%w: more than %d shard ids", ErrApplicationInvalidShardIDs, MaxShardIDs)
+		}
+
+		for i := low; i <= high; i++ {
+			result = append(result, int32(i))
+		}
+	}
+
+	return result, nil
+}
+
 func unmarshalPayload(payload *discord.GatewayPayload, out any) error {
 	err := json.Unmarshal(payload.Data, out)
 	if err != nil {
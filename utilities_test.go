@@ -0,0 +1,21 @@
+package sandwich_test
+
+import (
+	"testing"
+
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseShardIDs(t *testing.T) {
+	t.Parallel()
+
+	shardIDs, err := sandwich.ParseShardIDs("0-2, 4,6-7")
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{0, 1, 2, 4, 6, 7}, shardIDs)
+
+	for _, rangeString := range []string{"", "a", "-1", "3-1", "0-2147483647", "0-40000,40001-80000"} {
+		_, err := sandwich.ParseShardIDs(rangeString)
+		assert.ErrorIs(t, err, sandwich.ErrApplicationInvalidShardIDs, rangeString)
+	}
+}
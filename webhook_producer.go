@@ -0,0 +1,521 @@
+package sandwich
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// WebhookProducerType is the producer type of webhook producers, which is registered by default.
+const WebhookProducerType = "webhook"
+
+const (
+	// WebhookSignatureHeader is the hex encoded HMAC-SHA256 of the timestamp, a period and the body, prefixed with sha256=.
+	WebhookSignatureHeader = "X-Sandwich-Signature"
+	// WebhookTimestampHeader is the unix time in seconds the request was signed at.
+	WebhookTimestampHeader = "X-Sandwich-Timestamp"
+)
+
+var (
+	// DefaultWebhookTimeout is the timeout of each request when TimeoutMilliseconds is not set.
+	DefaultWebhookTimeout = time.Second * 10
+	// DefaultWebhookMaxConcurrency is how many requests can be sent at once when MaxConcurrency is not set.
+	DefaultWebhookMaxConcurrency = 16
+	// DefaultWebhookMaxAttempts is how many times a request is sent when MaxAttempts is not set.
+	DefaultWebhookMaxAttempts = 3
+	// DefaultWebhookBatchInterval is the longest a batch waits before it is sent when BatchIntervalMilliseconds is not set.
+	DefaultWebhookBatchInterval = time.Second
+	// WebhookRetryBackoff is the wait before the first retry, doubled for every retry after.
+	WebhookRetryBackoff = time.Millisecond * 250
+	// WebhookMaxRetryAfter is the longest Retry-After that is waited for.
+	WebhookMaxRetryAfter = time.Second * 30
+)
+
+// WebhookProducerConfiguration is where and how a webhook producer sends events.
+type WebhookProducerConfiguration struct {
+	URL string `json:"url"`
+	// Secret signs each request with HMAC-SHA256. Requests are not signed when empty.
+	// It can be a reference such as env:NAME, which is resolved by the secret provider.
+	Secret string `json:"secret"`
+	// Headers are added to each request.
+	Headers map[string]string `json:"headers"`
+
+	TimeoutMilliseconds int32 `json:"timeout_milliseconds"`
+	MaxConcurrency      int32 `json:"max_concurrency"`
+	// MaxAttempts is how many times a request is sent when the response is a 429 or 5xx.
+	MaxAttempts int32 `json:"max_attempts"`
+
+	// BatchSize sends events in JSON arrays of up to this many events. Each event is sent on its own when 0 or 1.
+	// Batched events are sent in the background, so failures are not returned from Publish. They are logged
+	// and, when producer_retry has a dead_letter_path, written to the dead letter file.
+	// Publish blocks while as many batches as MaxConcurrency are waiting to be sent.
+	BatchSize                 int32 `json:"batch_size"`
+	BatchIntervalMilliseconds int32 `json:"batch_interval_milliseconds"`
+
+	// Format is json, protobuf or msgpack. Defaults to json. Only json can be batched.
+	Format PayloadFormat `json:"format"`
+}
+
+func (config WebhookProducerConfiguration) validate() error {
+	parsedURL, err := url.Parse(config.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return fmt.Errorf("url must be http or https, got %q", config.URL)
+	}
+
+	if config.TimeoutMilliseconds < 0 || config.MaxConcurrency < 0 || config.MaxAttempts < 0 ||
+		config.BatchSize < 0 || config.BatchIntervalMilliseconds < 0 {
+		return errors.New("timeout_milliseconds, max_concurrency, max_attempts, batch_size and batch_interval_milliseconds must not be negative")
+	}
+
+	serializer, err := NewPayloadSerializer(config.Format)
+	if err != nil {
+		return err
+	}
+
+	if config.BatchSize > 1 && serializer != JSONPayloadSerializer {
+		return fmt.Errorf("batch_size requires the json format, got %q", config.Format)
+	}
+
+	return nil
+}
+
+// WebhookProducer is a producer that POSTs events to a URL.
+type WebhookProducer struct {
+	logger     *slog.Logger
+	identifier string
+	client     *http.Client
+
+	configuration WebhookProducerConfiguration
+	secret        []byte
+	serializer    PayloadSerializer
+	timeout       time.Duration
+	maxAttempts   int
+
+	// semaphore limits how many requests are sent at once.
+	semaphore chan struct{}
+
+	batchMu     sync.Mutex
+	batch       [][]byte
+	batchClosed bool
+	// batches are the batches waiting to be sent, which are sent by a worker per concurrent request.
+	batches      chan [][]byte
+	batchPending sync.WaitGroup
+	batchWorkers sync.WaitGroup
+	// onBatchFailure is called with the events of batches that failed to send.
+	onBatchFailure *atomic.Pointer[func(payloads []ProducedPayload, err error) error]
+	cancel         context.CancelFunc
+	closeOnce      sync.Once
+}
+
+// NewWebhookProducer returns a producer that POSTs events to the configured URL. The secret must already be resolved.
+// Identifier is the application identifier used in logs and metrics.
+func NewWebhookProducer(logger *slog.Logger, client *http.Client, identifier string, configuration WebhookProducerConfiguration) (*WebhookProducer, error) {
+	if err := configuration.validate(); err != nil {
+		return nil, err
+	}
+
+	p := &WebhookProducer{
+		logger:     logger.With("webhook", configuration.URL),
+		identifier: identifier,
+		client:     client,
+
+		configuration: configuration,
+		secret:        []byte(configuration.Secret),
+		timeout:       DefaultWebhookTimeout,
+		maxAttempts:   DefaultWebhookMaxAttempts,
+
+		semaphore: make(chan struct{}, DefaultWebhookMaxConcurrency),
+
+		onBatchFailure: &atomic.Pointer[func(payloads []ProducedPayload, err error) error]{},
+	}
+
+	// The format has already been validated.
+	p.serializer, _ = NewPayloadSerializer(configuration.Format)
+
+	if configuration.TimeoutMilliseconds > 0 {
+		p.timeout = time.Duration(configuration.TimeoutMilliseconds) * time.Millisecond
+	}
+
+	if configuration.MaxAttempts > 0 {
+		p.maxAttempts = int(configuration.MaxAttempts)
+	}
+
+	if configuration.MaxConcurrency > 0 {
+		p.semaphore = make(chan struct{}, configuration.MaxConcurrency)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	if p.batching() {
+		interval := DefaultWebhookBatchInterval
+		if configuration.BatchIntervalMilliseconds > 0 {
+			interval = time.Duration(configuration.BatchIntervalMilliseconds) * time.Millisecond
+		}
+
+		p.batches = make(chan [][]byte, cap(p.semaphore))
+
+		for range cap(p.semaphore) {
+			p.batchWorkers.Add(1)
+
+			go p.sendBatches()
+		}
+
+		go p.sendBatchesEvery(ctx, interval)
+	}
+
+	return p, nil
+}
+
+func (p *WebhookProducer) batching() bool {
+	return p.configuration.BatchSize > 1
+}
+
+// Publish sends the event. When batching, the event is added to the batch, which is sent once it is full.
+func (p *WebhookProducer) Publish(ctx context.Context, _ *Shard, payload *ProducedPayload) error {
+	body, err := p.serializer.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	if !p.batching() {
+		return p.send(ctx, body)
+	}
+
+	p.batchMu.Lock()
+	defer p.batchMu.Unlock()
+
+	if p.batchClosed {
+		return ErrProducerClosed
+	}
+
+	p.batch = append(p.batch, body)
+
+	if len(p.batch) >= int(p.configuration.BatchSize) {
+		p.queueBatchLocked()
+	}
+
+	return nil
+}
+
+// sendBatch queues the current batch to be sent in the background.
+func (p *WebhookProducer) sendBatch() {
+	p.batchMu.Lock()
+	defer p.batchMu.Unlock()
+
+	p.queueBatchLocked()
+}
+
+// queueBatchLocked queues the current batch, waiting while the queue is full. Must be called with batchMu held.
+func (p *WebhookProducer) queueBatchLocked() {
+	if p.batchClosed || len(p.batch) == 0 {
+		return
+	}
+
+	p.batchPending.Add(1)
+	p.batches <- p.batch
+	p.batch = nil
+}
+
+// sendBatches sends queued batches until the queue is closed.
+func (p *WebhookProducer) sendBatches() {
+	defer p.batchWorkers.Done()
+
+	for batch := range p.batches {
+		body := make([]byte, 0, len(batch)*512)
+		body = append(body, '[')
+
+		for index, event := range batch {
+			if index > 0 {
+				body = append(body, ',')
+			}
+
+			body = append(body, event...)
+		}
+
+		body = append(body, ']')
+
+		if err := p.send(context.Background(), body); err != nil {
+			p.logger.Error("Failed to send webhook batch", "events", len(batch), "error", err)
+
+			p.batchFailed(batch, err)
+		}
+
+		p.batchPending.Done()
+	}
+}
+
+// OnBackgroundFailure sets the function called with the events of batches that failed to send.
+func (p *WebhookProducer) OnBackgroundFailure(fn func(payloads []ProducedPayload, err error) error) {
+	p.onBatchFailure.Store(&fn)
+}
+
+// batchFailed passes the events of a batch that failed to send to the failure handler.
+func (p *WebhookProducer) batchFailed(batch [][]byte, err error) {
+	onBatchFailure := p.onBatchFailure.Load()
+	if onBatchFailure == nil {
+		return
+	}
+
+	// Events are decoded again, as the payloads published may be reused once Publish returns.
+	payloads := make([]ProducedPayload, 0, len(batch))
+
+	for _, event := range batch {
+		var payload ProducedPayload
+
+		if decodeErr := p.serializer.Unmarshal(event, &payload); decodeErr != nil {
+			p.logger.Error("Failed to decode event of failed webhook batch", "error", decodeErr)
+
+			continue
+		}
+
+		payloads = append(payloads, payload)
+	}
+
+	if handlerErr := (*onBatchFailure)(payloads, err); handlerErr != nil {
+		p.logger.Error("Failed to handle failed webhook batch", "events", len(payloads), "error", handlerErr)
+	}
+}
+
+func (p *WebhookProducer) sendBatchesEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.sendBatch()
+		}
+	}
+}
+
+// send POSTs the body, retrying 429 and 5xx responses.
+func (p *WebhookProducer) send(ctx context.Context, body []byte) error {
+	select {
+	case p.semaphore <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	defer func() { <-p.semaphore }()
+
+	backoff := WebhookRetryBackoff
+
+	for attempt := 1; ; attempt++ {
+		statusCode, retryAfter, err := p.post(ctx, body)
+
+		RecordWebhookRequest(p.identifier, statusCode)
+
+		if err == nil {
+			return nil
+		}
+
+		retryable := statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+		if !retryable {
+			return fmt.Errorf("%w: %w", ErrProducerPermanent, err)
+		}
+
+		if attempt >= p.maxAttempts {
+			return err
+		}
+
+		wait := backoff
+		if retryAfter > 0 {
+			wait = min(retryAfter, WebhookMaxRetryAfter)
+		}
+
+		backoff *= 2
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+}
+
+// post sends a single request. Returns the status code, which is 0 if there was no response, and the Retry-After.
+func (p *WebhookProducer) post(ctx context.Context, body []byte) (int, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.configuration.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set(PayloadContentTypeHeader, p.serializer.ContentType())
+	req.Header.Set("User-Agent", "Sandwich/"+Version)
+
+	for key, value := range p.configuration.Headers {
+		req.Header.Set(key, value)
+	}
+
+	if len(p.secret) > 0 {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+		req.Header.Set(WebhookTimestampHeader, timestamp)
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhook(p.secret, timestamp, body))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return resp.StatusCode, 0, nil
+	}
+
+	var retryAfter time.Duration
+
+	if seconds, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); err == nil && seconds > 0 {
+		retryAfter = time.Duration(seconds * float64(time.Second))
+	}
+
+	return resp.StatusCode, retryAfter, fmt.Errorf("webhook responded with %s", resp.Status)
+}
+
+// SignWebhook returns the hex encoded HMAC-SHA256 signature of a request, which receivers can use to verify it.
+func SignWebhook(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Flush sends the current batch and waits for batches that are being sent.
+func (p *WebhookProducer) Flush(ctx context.Context) error {
+	p.sendBatch()
+
+	done := make(chan struct{})
+
+	go func() {
+		p.batchPending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close sends the current batch and waits for the queued batches to be sent.
+func (p *WebhookProducer) Close() error {
+	p.closeOnce.Do(func() {
+		p.cancel()
+
+		p.batchMu.Lock()
+		p.queueBatchLocked()
+		p.batchClosed = true
+
+		if p.batches != nil {
+			close(p.batches)
+		}
+
+		p.batchMu.Unlock()
+
+		p.batchWorkers.Wait()
+	})
+
+	return nil
+}
+
+// WebhookProducerProvider is a ProducerProvider that creates webhook producers from a configuration per application.
+type WebhookProducerProvider struct {
+	logger         *slog.Logger
+	client         *http.Client
+	secretProvider SecretProvider
+	configurations map[string]WebhookProducerConfiguration
+}
+
+// NewWebhookProducerProvider returns a ProducerProvider that POSTs the events of each application to the
+// webhook configured for it, keyed by application identifier. Secrets are resolved with the secret provider,
+// or as env: and file: references when it is nil.
+func NewWebhookProducerProvider(logger *slog.Logger, client *http.Client, secretProvider SecretProvider, configurations map[string]WebhookProducerConfiguration) *WebhookProducerProvider {
+	if secretProvider == nil {
+		secretProvider = NewSecretProviderFromReferences()
+	}
+
+	return &WebhookProducerProvider{
+		logger:         logger,
+		client:         client,
+		secretProvider: secretProvider,
+		configurations: configurations,
+	}
+}
+
+func (p *WebhookProducerProvider) GetProducer(ctx context.Context, applicationIdentifier, _ string) (Producer, error) {
+	configuration, ok := p.configurations[applicationIdentifier]
+	if !ok {
+		return nil, fmt.Errorf("%w: no webhook for application %q", ErrProducerNotFound, applicationIdentifier)
+	}
+
+	configuration, err := resolveWebhookSecret(ctx, p.secretProvider, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWebhookProducer(p.logger, p.client, applicationIdentifier, configuration)
+}
+
+// resolveWebhookSecret resolves the secret reference of a webhook configuration.
+func resolveWebhookSecret(ctx context.Context, secretProvider SecretProvider, configuration WebhookProducerConfiguration) (WebhookProducerConfiguration, error) {
+	if configuration.Secret == "" {
+		return configuration, nil
+	}
+
+	secret, err := secretProvider.ResolveSecret(ctx, configuration.Secret)
+	if err != nil {
+		return configuration, fmt.Errorf("failed to resolve webhook secret: %w", err)
+	}
+
+	configuration.Secret = secret
+
+	return configuration, nil
+}
+
+// newWebhookProducerFactory returns the ProducerFactory of webhook producers, which are configured by their options.
+func newWebhookProducerFactory(client *http.Client) ProducerFactory {
+	return func(ctx context.Context, application *Application, producerConfiguration ProducerConfiguration) (Producer, error) {
+		var configuration WebhookProducerConfiguration
+
+		if err := json.Unmarshal(producerConfiguration.Options, &configuration); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal webhook options: %w", err)
+		}
+
+		configuration, err := resolveWebhookSecret(ctx, application.Sandwich.secretProvider, configuration)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewWebhookProducer(application.Logger, client, application.Identifier, configuration)
+	}
+}
@@ -0,0 +1,330 @@
+package sandwich_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/WelcomerTeam/Discord/discord"
+	sandwich "github.com/WelcomerTeam/Sandwich-Daemon"
+	"github.com/stretchr/testify/assert"
+)
+
+// webhookReceiver records the bodies of requests, responding with the next status code until none are left.
+type webhookReceiver struct {
+	mu       sync.Mutex
+	statuses []int
+	bodies   [][]byte
+	requests atomic.Int32
+}
+
+func (receiver *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	receiver.requests.Add(1)
+
+	body, _ := io.ReadAll(r.Body)
+
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+
+	if len(receiver.statuses) > 0 {
+		status := receiver.statuses[0]
+		receiver.statuses = receiver.statuses[1:]
+
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+
+			return
+		}
+	}
+
+	receiver.bodies = append(receiver.bodies, body)
+}
+
+func (receiver *webhookReceiver) received() [][]byte {
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+
+	return append([][]byte(nil), receiver.bodies...)
+}
+
+func TestWebhookProducerSignsRequests(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("secret")
+	verified := make(chan bool, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		signature := "sha256=" + sandwich.SignWebhook(secret, r.Header.Get(sandwich.WebhookTimestampHeader), body)
+
+		verified <- r.Header.Get(sandwich.WebhookSignatureHeader) == signature && r.Header.Get("X-Source") == "sandwich"
+	}))
+	defer server.Close()
+
+	producer, err := sandwich.NewWebhookProducer(slog.Default(), server.Client(), "welcomer", sandwich.WebhookProducerConfiguration{
+		URL:     server.URL,
+		Secret:  string(secret),
+		Headers: map[string]string{"X-Source": "sandwich"},
+	})
+	assert.NoError(t, err)
+
+	defer producer.Close()
+
+	assert.NoError(t, producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: "MESSAGE_CREATE"}}))
+	assert.True(t, <-verified)
+}
+
+func TestWebhookProducerProviderResolvesSecrets(t *testing.T) {
+	t.Parallel()
+
+	verified := make(chan bool, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		signature := "sha256=" + sandwich.SignWebhook([]byte("secret"), r.Header.Get(sandwich.WebhookTimestampHeader), body)
+
+		verified <- r.Header.Get(sandwich.WebhookSignatureHeader) == signature
+	}))
+	defer server.Close()
+
+	secretProvider := sandwich.NewSecretProviderInMemory(map[string]string{"webhook_secret": "secret"})
+
+	producer, err := sandwich.NewWebhookProducerProvider(slog.Default(), server.Client(), secretProvider, map[string]sandwich.WebhookProducerConfiguration{
+		"welcomer": {URL: server.URL, Secret: "webhook_secret"},
+	}).GetProducer(context.Background(), "welcomer", "")
+	assert.NoError(t, err)
+
+	defer producer.Close()
+
+	assert.NoError(t, producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: "MESSAGE_CREATE"}}))
+	assert.True(t, <-verified)
+}
+
+func TestWebhookProducerRetries(t *testing.T) {
+	t.Parallel()
+
+	receiver := &webhookReceiver{statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}}
+
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	producer, err := sandwich.NewWebhookProducer(slog.Default(), server.Client(), "welcomer", sandwich.WebhookProducerConfiguration{
+		URL:         server.URL,
+		MaxAttempts: 3,
+	})
+	assert.NoError(t, err)
+
+	defer producer.Close()
+
+	assert.NoError(t, producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: "MESSAGE_CREATE"}}))
+	assert.Equal(t, int32(3), receiver.requests.Load())
+	assert.Len(t, receiver.received(), 1)
+
+	// Other client errors are not retried.
+	receiver.mu.Lock()
+	receiver.statuses = []int{http.StatusBadRequest}
+	receiver.mu.Unlock()
+
+	err = producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: "MESSAGE_CREATE"}})
+	assert.ErrorIs(t, err, sandwich.ErrProducerPermanent)
+	assert.Equal(t, int32(4), receiver.requests.Load())
+}
+
+func TestWebhookProducerBatches(t *testing.T) {
+	t.Parallel()
+
+	receiver := &webhookReceiver{}
+
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	producer, err := sandwich.NewWebhookProducer(slog.Default(), server.Client(), "welcomer", sandwich.WebhookProducerConfiguration{
+		URL:                       server.URL,
+		BatchSize:                 3,
+		BatchIntervalMilliseconds: int32(time.Hour / time.Millisecond),
+	})
+	assert.NoError(t, err)
+
+	for _, eventType := range []string{"MESSAGE_CREATE", "MESSAGE_UPDATE", "MESSAGE_DELETE", "GUILD_UPDATE"} {
+		assert.NoError(t, producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: eventType}}))
+	}
+
+	// The first batch is full, the rest is sent once flushed.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	assert.NoError(t, producer.Flush(ctx))
+	assert.NoError(t, producer.Close())
+
+	var eventTypes []string
+
+	for _, body := range receiver.received() {
+		var batch []sandwich.ProducedPayload
+
+		assert.NoError(t, json.Unmarshal(body, &batch))
+
+		for _, payload := range batch {
+			eventTypes = append(eventTypes, payload.Type)
+		}
+	}
+
+	assert.Len(t, receiver.received(), 2)
+	assert.ElementsMatch(t, []string{"MESSAGE_CREATE", "MESSAGE_UPDATE", "MESSAGE_DELETE", "GUILD_UPDATE"}, eventTypes)
+}
+
+func TestWebhookProducerBatchFailuresAreDeadLettered(t *testing.T) {
+	t.Parallel()
+
+	receiver := &webhookReceiver{statuses: []int{http.StatusBadRequest}}
+
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	webhook, err := sandwich.NewWebhookProducer(slog.Default(), server.Client(), "welcomer", sandwich.WebhookProducerConfiguration{
+		URL:                       server.URL,
+		BatchSize:                 2,
+		BatchIntervalMilliseconds: int32(time.Hour / time.Millisecond),
+	})
+	assert.NoError(t, err)
+
+	spool, err := sandwich.OpenDeadLetterSpool("welcomer", filepath.Join(t.TempDir(), "dead_letters.ndjson"), 0)
+	assert.NoError(t, err)
+
+	defer spool.Close()
+
+	producer := sandwich.NewRetryingProducer("welcomer", "webhook", webhook, testRetryPolicy, spool)
+
+	// The batch is sent in the background, so Publish does not see the failure.
+	for _, eventType := range []string{"MESSAGE_CREATE", "GUILD_UPDATE"} {
+		assert.NoError(t, producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: eventType}}))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	assert.NoError(t, producer.Flush(ctx))
+	assert.NoError(t, producer.Close())
+
+	var eventTypes []string
+
+	replayed, failed, err := spool.Replay(context.Background(), func(_ context.Context, deadLetter *sandwich.DeadLetter) error {
+		assert.Equal(t, "webhook", deadLetter.Producer)
+		eventTypes = append(eventTypes, deadLetter.Payload.Type)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), replayed)
+	assert.Equal(t, int64(0), failed)
+	assert.Equal(t, []string{"MESSAGE_CREATE", "GUILD_UPDATE"}, eventTypes)
+}
+
+func TestWebhookProducerBatchQueueIsBounded(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+
+	producer, err := sandwich.NewWebhookProducer(slog.Default(), server.Client(), "welcomer", sandwich.WebhookProducerConfiguration{
+		URL:                       server.URL,
+		MaxConcurrency:            1,
+		BatchSize:                 2,
+		BatchIntervalMilliseconds: int32(time.Hour / time.Millisecond),
+	})
+	assert.NoError(t, err)
+
+	publish := func() error {
+		return producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{GatewayPayload: discord.GatewayPayload{Type: "MESSAGE_CREATE"}})
+	}
+
+	published := make(chan struct{})
+
+	// The first batch is being sent and the second is queued, so the third has to wait.
+	go func() {
+		defer close(published)
+
+		for range 6 {
+			assert.NoError(t, publish())
+		}
+	}()
+
+	select {
+	case <-published:
+		t.Fatal("publish did not wait for the batch queue")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	close(release)
+
+	select {
+	case <-published:
+	case <-time.After(time.Second * 5):
+		t.Fatal("publish did not continue once batches were sent")
+	}
+
+	assert.NoError(t, producer.Close())
+	assert.ErrorIs(t, publish(), sandwich.ErrProducerClosed)
+}
+
+func TestWebhookProducerFormat(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan *sandwich.ProducedPayload, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serializer := sandwich.JSONPayloadSerializer
+		if r.Header.Get(sandwich.PayloadContentTypeHeader) == sandwich.ContentTypeProtobuf {
+			serializer = sandwich.ProtobufPayloadSerializer
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		var payload sandwich.ProducedPayload
+
+		if err := serializer.Unmarshal(body, &payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		received <- &payload
+	}))
+	defer server.Close()
+
+	producer, err := sandwich.NewWebhookProducer(slog.Default(), server.Client(), "welcomer", sandwich.WebhookProducerConfiguration{
+		URL:    server.URL,
+		Format: sandwich.PayloadFormatProtobuf,
+	})
+	assert.NoError(t, err)
+
+	defer producer.Close()
+
+	assert.NoError(t, producer.Publish(context.Background(), nil, &sandwich.ProducedPayload{
+		GatewayPayload: discord.GatewayPayload{Type: "MESSAGE_CREATE"},
+		Metadata:       sandwich.ProducedMetadata{Identifier: "welcomer"},
+	}))
+
+	payload := <-received
+	assert.Equal(t, "MESSAGE_CREATE", payload.Type)
+	assert.Equal(t, "welcomer", payload.Metadata.Identifier)
+
+	// Only JSON can be batched.
+	_, err = sandwich.NewWebhookProducer(slog.Default(), server.Client(), "welcomer", sandwich.WebhookProducerConfiguration{
+		URL:       server.URL,
+		Format:    sandwich.PayloadFormatMsgpack,
+		BatchSize: 10,
+	})
+	assert.Error(t, err)
+}